@@ -20,33 +20,105 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ArmamentKind classifies a catalog armament. Each category of kinds has
+// its own attribute block in ArmamentSpec.
+// +kubebuilder:validation:Enum=revolver;rifle;rope;blade
+type ArmamentKind string
+
+// Armament kinds offered by the catalog.
+const (
+	ArmamentKindRevolver ArmamentKind = "revolver"
+	ArmamentKindRifle    ArmamentKind = "rifle"
+	ArmamentKindRope     ArmamentKind = "rope"
+	ArmamentKindBlade    ArmamentKind = "blade"
+)
+
+// IsFirearm reports whether armaments of kind k carry FirearmAttributes.
+func (k ArmamentKind) IsFirearm() bool {
+	return k == ArmamentKindRevolver || k == ArmamentKindRifle
+}
+
+// FirearmAttributes describe revolvers and rifles.
+type FirearmAttributes struct {
+	// Caliber of the ammunition the firearm takes, e.g. ".45 Colt".
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Caliber string `json:"caliber,omitempty"`
+
+	// Capacity is the number of rounds the firearm holds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Capacity int32 `json:"capacity,omitempty"`
+}
+
+// RopeAttributes describe ropes.
+type RopeAttributes struct {
+	// Length of the rope in meters.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Length int32 `json:"length,omitempty"`
+}
+
+// BladeAttributes describe blades.
+type BladeAttributes struct {
+	// Length of the blade in centimeters.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Length int32 `json:"length,omitempty"`
+}
+
 // ArmamentSpec defines the desired state of Armament. Armaments are catalog
 // items synced from an external source by the armament-sync controller and
 // exposed to consumer workspaces as read-only cached resources.
+//
+// Kind discriminates the category-specific attribute blocks: only the block
+// matching the kind may be set.
+// +kubebuilder:validation:XValidation:rule="!has(self.firearm) || self.kind == 'revolver' || self.kind == 'rifle'",message="firearm is only allowed for revolver and rifle armaments"
+// +kubebuilder:validation:XValidation:rule="!has(self.rope) || self.kind == 'rope'",message="rope is only allowed for rope armaments"
+// +kubebuilder:validation:XValidation:rule="!has(self.blade) || self.kind == 'blade'",message="blade is only allowed for blade armaments"
 type ArmamentSpec struct {
 	// ExternalID identifies the armament in the external source system and
 	// is used by the sync controller to detect updates and deletions.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="externalID is immutable"
 	ExternalID string `json:"externalID"`
 
 	// DisplayName is a human-readable name shown to consumers.
 	DisplayName string `json:"displayName"`
 
-	// Kind classifies the armament (e.g. "revolver", "rifle", "lasso").
-	Kind string `json:"kind"`
+	// Kind classifies the armament.
+	Kind ArmamentKind `json:"kind"`
 
 	// Damage is the armament's damage rating.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="self >= 0",message="damage must not be negative"
 	Damage int32 `json:"damage,omitempty"`
 
 	// Range is the armament's effective range in meters.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="self >= 0",message="range must not be negative"
 	Range int32 `json:"range,omitempty"`
+
+	// Firearm holds the attributes of revolvers and rifles.
+	// +optional
+	Firearm *FirearmAttributes `json:"firearm,omitempty"`
+
+	// Rope holds the attributes of ropes.
+	// +optional
+	Rope *RopeAttributes `json:"rope,omitempty"`
+
+	// Blade holds the attributes of blades.
+	// +optional
+	Blade *BladeAttributes `json:"blade,omitempty"`
 }
 
 // ArmamentStatus defines the observed state of Armament.
 type ArmamentStatus struct {
-	// LastSyncedAt is the time the armament was last reconciled against the
-	// external source.
+	// LastSyncedAt is the time the armament was last created or changed to
+	// match the external source. Unchanged armaments are not stamped on
+	// every sync; the provider records the time of the last sync run on its
+	// ArmamentCatalog instead.
 	// +optional
 	LastSyncedAt *metav1.Time `json:"lastSyncedAt,omitempty"`
 }
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArmamentCatalogName is the name of the ArmamentCatalog the armament-sync
+// controller maintains in the provider workspace.
+const ArmamentCatalogName = "armaments"
+
+// ArmamentCatalogStatus defines the observed state of ArmamentCatalog.
+type ArmamentCatalogStatus struct {
+	// Watermark is the opaque position in the external source's change feed
+	// up to which changes have been applied. It is empty for sources without
+	// a change feed.
+	// +optional
+	Watermark string `json:"watermark,omitempty"`
+
+	// LastFullSyncTime is when the complete catalog was last listed and
+	// reconciled.
+	// +optional
+	LastFullSyncTime *metav1.Time `json:"lastFullSyncTime,omitempty"`
+
+	// LastSyncTime is when the last sync run, full or incremental,
+	// completed. It tells how fresh every synced Armament is, since
+	// Armaments themselves are only written when they change.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Armaments is the number of items in the external catalog as of the
+	// last full sync.
+	// +optional
+	Armaments int32 `json:"armaments,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Armaments",type=integer,JSONPath=`.status.armaments`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`
+// +kubebuilder:printcolumn:name="Last Full Sync",type=date,JSONPath=`.status.lastFullSyncTime`
+// +kubebuilder:printcolumn:name="Watermark",type=string,JSONPath=`.status.watermark`
+
+// ArmamentCatalog records the state of the armament-sync controller's
+// projection of the external catalog, most notably how fresh it is. It
+// lives in the provider workspace, is maintained by armament-sync and is not
+// exported to consumers.
+type ArmamentCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ArmamentCatalogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ArmamentCatalogList contains a list of ArmamentCatalog.
+type ArmamentCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArmamentCatalog `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArmamentCatalog{}, &ArmamentCatalogList{})
+}
@@ -24,46 +24,321 @@ import (
 type CowboySpec struct {
 	// Intent is the desired action for the cowboy
 	// +optional
+	// +kubebuilder:validation:MaxLength=256
 	Intent string `json:"intent,omitempty"`
 
 	// SecretRefs is an array of references to Secrets containing cowboy credentials
 	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name && y.namespace == x.namespace))",message="secretRefs must not reference the same Secret twice"
 	SecretRefs []SecretReference `json:"secretRefs,omitempty"`
 
 	// ArmamentRef references a standard Armament from the catalog exposed by
 	// the provider workspace as a cached resource.
 	// +optional
 	ArmamentRef *ArmamentReference `json:"armamentRef,omitempty"`
+
+	// DependsOn lists Cowboys in the same workspace whose current action
+	// must have succeeded before this cowboy's intent is started.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))",message="dependsOn must not list the same Cowboy twice"
+	DependsOn []CowboyReference `json:"dependsOn,omitempty"`
+
+	// Schedule re-runs the intent periodically. It uses standard five-field
+	// cron syntax, e.g. "0 6 * * *" for every day at 06:00 UTC; descriptors
+	// such as "@hourly" or "@every 1h" are not accepted. Without a schedule
+	// the intent runs once per change.
+	// +optional
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^\s*\S+(\s+\S+){4}\s*$`
+	Schedule string `json:"schedule,omitempty"`
+
+	// ConcurrencyPolicy decides what happens when a scheduled run comes due
+	// while the previous run is still pending or running. Defaults to
+	// Forbid.
+	// +optional
+	ConcurrencyPolicy CowboyConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// StartingDeadlineSeconds is how late a scheduled run may still start,
+	// e.g. after the controller was down. Runs that miss their deadline are
+	// skipped. Without a deadline a missed run always starts late; when
+	// several were missed only the latest one runs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
 }
 
+// CowboyConcurrencyPolicy decides how a scheduled run treats the run before
+// it.
+// +kubebuilder:validation:Enum=Forbid;Replace
+type CowboyConcurrencyPolicy string
+
+const (
+	// CowboyConcurrencyForbid skips a scheduled run while the previous run
+	// is still pending or running.
+	CowboyConcurrencyForbid CowboyConcurrencyPolicy = "Forbid"
+
+	// CowboyConcurrencyReplace ends the previous run and starts the
+	// scheduled one in its place.
+	CowboyConcurrencyReplace CowboyConcurrencyPolicy = "Replace"
+)
+
 // SecretReference references a Secret by name and namespace. Cowboys are
 // cluster-scoped so the namespace must be specified explicitly.
 type SecretReference struct {
+	// Name of the referenced Secret
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Namespace of the referenced Secret
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+
+	// Keys declares the keys the Secret must contain. The controller reports
+	// missing and malformed keys by name only; values are never copied into
+	// the status.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))",message="keys must not list the same key twice"
+	Keys []SecretKeyRequirement `json:"keys,omitempty"`
+}
+
+// SecretKeyRequirement declares a key a referenced Secret must contain.
+type SecretKeyRequirement struct {
+	// Name of the key in the Secret's data.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Name string `json:"name"`
+
+	// Pattern is a regular expression in RE2 syntax the key's value must
+	// match. Without a pattern any non-empty value is accepted.
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// SecretReferenceStatus reports the resolution of a single SecretReference.
+type SecretReferenceStatus struct {
 	// Name of the referenced Secret
 	Name string `json:"name"`
 
 	// Namespace of the referenced Secret
 	Namespace string `json:"namespace"`
+
+	// Resolved is true when the Secret exists and the provider can read it.
+	Resolved bool `json:"resolved"`
+
+	// Reason is a CamelCase explanation of the resolution outcome.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable explanation of the resolution outcome.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// MissingKeys lists the declared keys that are absent or empty in the
+	// Secret.
+	// +optional
+	MissingKeys []string `json:"missingKeys,omitempty"`
+
+	// InvalidKeys lists the declared keys whose value does not match their
+	// pattern.
+	// +optional
+	InvalidKeys []string `json:"invalidKeys,omitempty"`
 }
 
 // ArmamentReference references an Armament from the catalog by name.
 type ArmamentReference struct {
 	// Name of the referenced Armament.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// CowboyReference references another Cowboy in the same workspace by name.
+type CowboyReference struct {
+	// Name of the referenced Cowboy.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 }
 
+// Condition types reported on Cowboy status.
+const (
+	// CowboyConditionReady summarizes whether the cowboy's intent has been
+	// carried out for the current generation and all its references resolve.
+	CowboyConditionReady = "Ready"
+
+	// CowboyConditionSecretsResolved reports whether every entry in
+	// spec.secretRefs points at an existing Secret.
+	CowboyConditionSecretsResolved = "SecretsResolved"
+
+	// CowboyConditionArmamentResolved reports whether spec.armamentRef points
+	// at an Armament in the catalog.
+	CowboyConditionArmamentResolved = "ArmamentResolved"
+
+	// CowboyConditionWithinQuota reports whether the cowboy fits in the
+	// WildWestQuota the provider set for its workspace.
+	CowboyConditionWithinQuota = "WithinQuota"
+
+	// CowboyConditionBlocked is True while the cowboy waits for a Cowboy in
+	// spec.dependsOn, or when its dependencies form a cycle. The message
+	// names the unsatisfied dependency.
+	CowboyConditionBlocked = "Blocked"
+)
+
+// ArmamentSnapshot copies the consumer-relevant fields of a catalog
+// Armament into the cowboy's status.
+type ArmamentSnapshot struct {
+	// Name of the resolved Armament.
+	Name string `json:"name"`
+
+	// DisplayName is a human-readable name shown to consumers.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Kind classifies the armament.
+	// +optional
+	Kind ArmamentKind `json:"kind,omitempty"`
+
+	// Damage is the armament's damage rating.
+	// +optional
+	Damage int32 `json:"damage,omitempty"`
+
+	// Range is the armament's effective range in meters.
+	// +optional
+	Range int32 `json:"range,omitempty"`
+}
+
+// CowboyPhase is the lifecycle phase of the cowboy's current action.
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+type CowboyPhase string
+
+const (
+	// CowboyPhasePending means a new intent was picked up but its handler
+	// has not been started yet.
+	CowboyPhasePending CowboyPhase = "Pending"
+
+	// CowboyPhaseRunning means the intent handler is carrying out the
+	// action and is polled until it finishes.
+	CowboyPhaseRunning CowboyPhase = "Running"
+
+	// CowboyPhaseSucceeded means the action finished successfully.
+	CowboyPhaseSucceeded CowboyPhase = "Succeeded"
+
+	// CowboyPhaseFailed means the action finished unsuccessfully. A new
+	// intent is needed to try again.
+	CowboyPhaseFailed CowboyPhase = "Failed"
+)
+
+// CowboyHistoryLimit bounds the number of entries kept in CowboyStatus.History.
+const CowboyHistoryLimit = 10
+
+// CowboyAction records an intent the cowboy carried out and its outcome.
+type CowboyAction struct {
+	// Intent that was carried out.
+	Intent string `json:"intent"`
+
+	// Result is the outcome of the action.
+	// +optional
+	Result string `json:"result,omitempty"`
+
+	// Generation is the metadata.generation at which the action was picked up.
+	Generation int64 `json:"generation"`
+
+	// StartedAt is when the intent handler was first started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is when the action reached Succeeded or Failed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
 // CowboyStatus defines the observed state of Cowboy
 type CowboyStatus struct {
+	// ObservedGeneration is the most recent generation observed by the
+	// controller. Consumers compare it against metadata.generation to tell
+	// whether the status reflects the current spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Intent is the spec.intent that Result was produced for.
+	// +optional
+	Intent string `json:"intent,omitempty"`
+
+	// IntentGeneration is the metadata.generation at which Intent was
+	// picked up. A new action starts when the generation moves past it with
+	// a different intent.
+	// +optional
+	IntentGeneration int64 `json:"intentGeneration,omitempty"`
+
 	// Result is the outcome of the cowboy's action
 	// +optional
 	Result string `json:"result,omitempty"`
+
+	// Phase is the lifecycle phase of the action for Intent. It is empty
+	// while the cowboy has no intent.
+	// +optional
+	Phase CowboyPhase `json:"phase,omitempty"`
+
+	// StartedAt is when the intent handler was first started for Intent.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is when the action for Intent reached Succeeded or Failed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// LastRunTime is when spec.schedule last came due.
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// NextRunTime is when spec.schedule comes due next. It is empty without
+	// a schedule.
+	// +optional
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// ScheduledFor is the spec.schedule that NextRunTime was computed from.
+	// When spec.schedule no longer matches it, NextRunTime is recomputed.
+	// +optional
+	ScheduledFor string `json:"scheduledFor,omitempty"`
+
+	// History lists earlier actions, most recent first. It holds at most
+	// CowboyHistoryLimit entries; older entries are dropped.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	History []CowboyAction `json:"history,omitempty"`
+
+	// SecretRefs reports, for every entry in spec.secretRefs, whether the
+	// referenced Secret could be resolved in the consumer workspace.
+	// +optional
+	SecretRefs []SecretReferenceStatus `json:"secretRefs,omitempty"`
+
+	// Armament is a snapshot of the catalog item referenced by
+	// spec.armamentRef. It is cleared when the reference does not resolve.
+	// +optional
+	Armament *ArmamentSnapshot `json:"armament,omitempty"`
+
+	// Conditions describe the current state of the cowboy.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Intent",type=string,JSONPath=`.spec.intent`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Result",type=string,JSONPath=`.status.result`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 
 // Cowboy is the Schema for the cowboys API
 type Cowboy struct {
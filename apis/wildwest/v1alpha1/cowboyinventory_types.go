@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CowboyInventoryErrorLimit bounds the number of entries kept in
+// CowboyInventoryStatus.Errors.
+const CowboyInventoryErrorLimit = 5
+
+// CowboyPhaseCounts counts Cowboys by the phase of their current action.
+type CowboyPhaseCounts struct {
+	// Idle counts Cowboys without an intent.
+	// +optional
+	Idle int32 `json:"idle,omitempty"`
+
+	// Pending counts Cowboys whose action has not started yet.
+	// +optional
+	Pending int32 `json:"pending,omitempty"`
+
+	// Running counts Cowboys whose action is in progress.
+	// +optional
+	Running int32 `json:"running,omitempty"`
+
+	// Succeeded counts Cowboys whose action succeeded.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// Failed counts Cowboys whose action failed.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+}
+
+// CowboyError describes why a Cowboy is not ready.
+type CowboyError struct {
+	// Name of the Cowboy.
+	Name string `json:"name"`
+
+	// Reason is the reason of the Cowboy's Ready condition.
+	Reason string `json:"reason"`
+
+	// Message is the message of the Cowboy's Ready condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Since is when the Cowboy's Ready condition last changed.
+	// +optional
+	Since metav1.Time `json:"since,omitempty"`
+}
+
+// CowboyInventoryStatus summarizes the Cowboys of one consumer workspace.
+type CowboyInventoryStatus struct {
+	// Cluster is the logical cluster name of the consumer workspace.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// Cowboys is the number of Cowboys in the workspace.
+	// +optional
+	Cowboys int32 `json:"cowboys,omitempty"`
+
+	// Phases breaks Cowboys down by the phase of their current action.
+	// +optional
+	Phases CowboyPhaseCounts `json:"phases,omitempty"`
+
+	// NotReady counts Cowboys whose Ready condition is False.
+	// +optional
+	NotReady int32 `json:"notReady,omitempty"`
+
+	// Errors lists the most recent errors among the workspace's Cowboys,
+	// newest first. Pending and running actions, including those waiting for
+	// a dependency, are not errors. It holds at most
+	// CowboyInventoryErrorLimit entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	Errors []CowboyError `json:"errors,omitempty"`
+
+	// LastUpdated is when this summary last changed.
+	// +optional
+	LastUpdated metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cowboys",type=integer,JSONPath=`.status.cowboys`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.phases.failed`
+// +kubebuilder:printcolumn:name="Not Ready",type=integer,JSONPath=`.status.notReady`
+// +kubebuilder:printcolumn:name="Last Updated",type=date,JSONPath=`.status.lastUpdated`
+
+// CowboyInventory summarizes the Cowboys of one consumer workspace bound to
+// the APIExport and is named after the workspace's logical cluster. It lives
+// in the provider workspace, is maintained by the wild-west controller and is
+// not exported to consumers.
+type CowboyInventory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status CowboyInventoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CowboyInventoryList contains a list of CowboyInventory.
+type CowboyInventoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CowboyInventory `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CowboyInventory{}, &CowboyInventoryList{})
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoadoutItem requests a quantity of one catalog Armament.
+type LoadoutItem struct {
+	// ArmamentRef references the requested Armament in the catalog.
+	ArmamentRef ArmamentReference `json:"armamentRef"`
+
+	// Quantity is the number of pieces requested.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Quantity int32 `json:"quantity"`
+}
+
+// LoadoutSpec defines the desired state of Loadout
+type LoadoutSpec struct {
+	// Items lists the requested armaments. Each armament may appear once.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.armamentRef.name == x.armamentRef.name))",message="items must not request the same armament twice"
+	Items []LoadoutItem `json:"items"`
+}
+
+// Condition types reported on Loadout status.
+const (
+	// LoadoutConditionReady summarizes whether the loadout can be issued:
+	// every item resolves and the workspace quota admits it.
+	LoadoutConditionReady = "Ready"
+
+	// LoadoutConditionArmamentsResolved reports whether every item
+	// references an Armament in the catalog.
+	LoadoutConditionArmamentsResolved = "ArmamentsResolved"
+
+	// LoadoutConditionWithinQuota reports whether the loadout fits in the
+	// workspace's armament quota.
+	LoadoutConditionWithinQuota = "WithinQuota"
+)
+
+// LoadoutItemStatus reports the resolution of a single LoadoutItem.
+type LoadoutItemStatus struct {
+	// Name of the requested Armament.
+	Name string `json:"name"`
+
+	// Quantity is the number of pieces requested.
+	Quantity int32 `json:"quantity"`
+
+	// Resolved is true when the Armament is in the catalog.
+	Resolved bool `json:"resolved"`
+
+	// Armament is a snapshot of the resolved catalog item.
+	// +optional
+	Armament *ArmamentSnapshot `json:"armament,omitempty"`
+}
+
+// LoadoutStatus defines the observed state of Loadout
+type LoadoutStatus struct {
+	// ObservedGeneration is the most recent generation observed by the
+	// controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Items reports, for every entry in spec.items, whether the requested
+	// Armament could be resolved.
+	// +optional
+	Items []LoadoutItemStatus `json:"items,omitempty"`
+
+	// TotalQuantity is the number of pieces requested across all items.
+	// +optional
+	TotalQuantity int32 `json:"totalQuantity,omitempty"`
+
+	// TotalDamage is the summed damage rating of all resolved pieces.
+	// +optional
+	TotalDamage int32 `json:"totalDamage,omitempty"`
+
+	// Conditions describe the current state of the loadout.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Pieces",type=integer,JSONPath=`.status.totalQuantity`
+// +kubebuilder:printcolumn:name="Damage",type=integer,JSONPath=`.status.totalDamage`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+
+// Loadout is a consumer's request for a set of catalog armaments.
+type Loadout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadoutSpec   `json:"spec,omitempty"`
+	Status LoadoutStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadoutList contains a list of Loadout
+type LoadoutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Loadout `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Loadout{}, &LoadoutList{})
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WildWestQuotaSpec defines the limits for Cowboys and Loadouts in consumer
+// workspaces.
+type WildWestQuotaSpec struct {
+	// Cluster is the logical cluster name of the consumer workspace this
+	// quota applies to. An empty cluster makes this the default quota for
+	// every workspace that has no quota of its own.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// MaxCowboys is the maximum number of Cowboys in the workspace. Unset
+	// means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxCowboys *int32 `json:"maxCowboys,omitempty"`
+
+	// MaxArmamentDamage is the maximum summed damage rating of the
+	// armaments referenced by the workspace's Cowboys. Unset means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxArmamentDamage *int32 `json:"maxArmamentDamage,omitempty"`
+
+	// MaxLoadoutPieces is the maximum number of armament pieces all
+	// Loadouts in the workspace may request together. Unset means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxLoadoutPieces *int32 `json:"maxLoadoutPieces,omitempty"`
+}
+
+// WildWestQuotaUsage reports the usage of one consumer workspace.
+type WildWestQuotaUsage struct {
+	// Cluster is the logical cluster name of the consumer workspace.
+	Cluster string `json:"cluster"`
+
+	// Cowboys is the number of Cowboys in the workspace.
+	Cowboys int32 `json:"cowboys"`
+
+	// ArmamentDamage is the summed damage rating of the armaments the
+	// workspace's Cowboys reference.
+	ArmamentDamage int32 `json:"armamentDamage"`
+
+	// Rejected is the number of Cowboys that do not fit in the quota.
+	// +optional
+	Rejected int32 `json:"rejected,omitempty"`
+}
+
+// WildWestQuotaStatus defines the observed state of WildWestQuota.
+type WildWestQuotaStatus struct {
+	// Usage lists the usage of every workspace the quota applies to, as
+	// last reported by the wild-west controller.
+	// +optional
+	// +listType=map
+	// +listMapKey=cluster
+	Usage []WildWestQuotaUsage `json:"usage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster`
+// +kubebuilder:printcolumn:name="Max Cowboys",type=integer,JSONPath=`.spec.maxCowboys`
+// +kubebuilder:printcolumn:name="Max Damage",type=integer,JSONPath=`.spec.maxArmamentDamage`
+// +kubebuilder:printcolumn:name="Max Pieces",type=integer,JSONPath=`.spec.maxLoadoutPieces`
+
+// WildWestQuota limits the Cowboys and Loadouts consumer workspaces may
+// create. It lives in the provider workspace and is not exported to
+// consumers.
+type WildWestQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WildWestQuotaSpec   `json:"spec,omitempty"`
+	Status WildWestQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WildWestQuotaList contains a list of WildWestQuota.
+type WildWestQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WildWestQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WildWestQuota{}, &WildWestQuotaList{})
+}
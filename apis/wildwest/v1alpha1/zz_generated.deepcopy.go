@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,7 +30,7 @@ func (in *Armament) DeepCopyInto(out *Armament) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -51,6 +52,87 @@ func (in *Armament) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmamentCatalog) DeepCopyInto(out *ArmamentCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmamentCatalog.
+func (in *ArmamentCatalog) DeepCopy() *ArmamentCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmamentCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArmamentCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmamentCatalogList) DeepCopyInto(out *ArmamentCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArmamentCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmamentCatalogList.
+func (in *ArmamentCatalogList) DeepCopy() *ArmamentCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmamentCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArmamentCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmamentCatalogStatus) DeepCopyInto(out *ArmamentCatalogStatus) {
+	*out = *in
+	if in.LastFullSyncTime != nil {
+		in, out := &in.LastFullSyncTime, &out.LastFullSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmamentCatalogStatus.
+func (in *ArmamentCatalogStatus) DeepCopy() *ArmamentCatalogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmamentCatalogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArmamentList) DeepCopyInto(out *ArmamentList) {
 	*out = *in
@@ -98,9 +180,39 @@ func (in *ArmamentReference) DeepCopy() *ArmamentReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmamentSnapshot) DeepCopyInto(out *ArmamentSnapshot) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmamentSnapshot.
+func (in *ArmamentSnapshot) DeepCopy() *ArmamentSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmamentSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArmamentSpec) DeepCopyInto(out *ArmamentSpec) {
 	*out = *in
+	if in.Firearm != nil {
+		in, out := &in.Firearm, &out.Firearm
+		*out = new(FirearmAttributes)
+		**out = **in
+	}
+	if in.Rope != nil {
+		in, out := &in.Rope, &out.Rope
+		*out = new(RopeAttributes)
+		**out = **in
+	}
+	if in.Blade != nil {
+		in, out := &in.Blade, &out.Blade
+		*out = new(BladeAttributes)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmamentSpec.
@@ -132,13 +244,28 @@ func (in *ArmamentStatus) DeepCopy() *ArmamentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BladeAttributes) DeepCopyInto(out *BladeAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BladeAttributes.
+func (in *BladeAttributes) DeepCopy() *BladeAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(BladeAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cowboy) DeepCopyInto(out *Cowboy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cowboy.
@@ -159,6 +286,127 @@ func (in *Cowboy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CowboyAction) DeepCopyInto(out *CowboyAction) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyAction.
+func (in *CowboyAction) DeepCopy() *CowboyAction {
+	if in == nil {
+		return nil
+	}
+	out := new(CowboyAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CowboyError) DeepCopyInto(out *CowboyError) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyError.
+func (in *CowboyError) DeepCopy() *CowboyError {
+	if in == nil {
+		return nil
+	}
+	out := new(CowboyError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CowboyInventory) DeepCopyInto(out *CowboyInventory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyInventory.
+func (in *CowboyInventory) DeepCopy() *CowboyInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(CowboyInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CowboyInventory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CowboyInventoryList) DeepCopyInto(out *CowboyInventoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CowboyInventory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyInventoryList.
+func (in *CowboyInventoryList) DeepCopy() *CowboyInventoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(CowboyInventoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CowboyInventoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CowboyInventoryStatus) DeepCopyInto(out *CowboyInventoryStatus) {
+	*out = *in
+	out.Phases = in.Phases
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]CowboyError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyInventoryStatus.
+func (in *CowboyInventoryStatus) DeepCopy() *CowboyInventoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CowboyInventoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CowboyList) DeepCopyInto(out *CowboyList) {
 	*out = *in
@@ -191,19 +439,61 @@ func (in *CowboyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CowboyPhaseCounts) DeepCopyInto(out *CowboyPhaseCounts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyPhaseCounts.
+func (in *CowboyPhaseCounts) DeepCopy() *CowboyPhaseCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(CowboyPhaseCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CowboyReference) DeepCopyInto(out *CowboyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyReference.
+func (in *CowboyReference) DeepCopy() *CowboyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CowboyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CowboySpec) DeepCopyInto(out *CowboySpec) {
 	*out = *in
 	if in.SecretRefs != nil {
 		in, out := &in.SecretRefs, &out.SecretRefs
 		*out = make([]SecretReference, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ArmamentRef != nil {
 		in, out := &in.ArmamentRef, &out.ArmamentRef
 		*out = new(ArmamentReference)
 		**out = **in
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]CowboyReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboySpec.
@@ -219,6 +509,48 @@ func (in *CowboySpec) DeepCopy() *CowboySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CowboyStatus) DeepCopyInto(out *CowboyStatus) {
 	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]CowboyAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecretRefs != nil {
+		in, out := &in.SecretRefs, &out.SecretRefs
+		*out = make([]SecretReferenceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Armament != nil {
+		in, out := &in.Armament, &out.Armament
+		*out = new(ArmamentSnapshot)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CowboyStatus.
@@ -231,9 +563,203 @@ func (in *CowboyStatus) DeepCopy() *CowboyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirearmAttributes) DeepCopyInto(out *FirearmAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirearmAttributes.
+func (in *FirearmAttributes) DeepCopy() *FirearmAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(FirearmAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Loadout) DeepCopyInto(out *Loadout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Loadout.
+func (in *Loadout) DeepCopy() *Loadout {
+	if in == nil {
+		return nil
+	}
+	out := new(Loadout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Loadout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadoutItem) DeepCopyInto(out *LoadoutItem) {
+	*out = *in
+	out.ArmamentRef = in.ArmamentRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadoutItem.
+func (in *LoadoutItem) DeepCopy() *LoadoutItem {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadoutItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadoutItemStatus) DeepCopyInto(out *LoadoutItemStatus) {
+	*out = *in
+	if in.Armament != nil {
+		in, out := &in.Armament, &out.Armament
+		*out = new(ArmamentSnapshot)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadoutItemStatus.
+func (in *LoadoutItemStatus) DeepCopy() *LoadoutItemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadoutItemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadoutList) DeepCopyInto(out *LoadoutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Loadout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadoutList.
+func (in *LoadoutList) DeepCopy() *LoadoutList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadoutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadoutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadoutSpec) DeepCopyInto(out *LoadoutSpec) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadoutItem, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadoutSpec.
+func (in *LoadoutSpec) DeepCopy() *LoadoutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadoutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadoutStatus) DeepCopyInto(out *LoadoutStatus) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadoutItemStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadoutStatus.
+func (in *LoadoutStatus) DeepCopy() *LoadoutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadoutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RopeAttributes) DeepCopyInto(out *RopeAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RopeAttributes.
+func (in *RopeAttributes) DeepCopy() *RopeAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(RopeAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRequirement) DeepCopyInto(out *SecretKeyRequirement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRequirement.
+func (in *SecretKeyRequirement) DeepCopy() *SecretKeyRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]SecretKeyRequirement, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
@@ -245,3 +771,152 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReferenceStatus) DeepCopyInto(out *SecretReferenceStatus) {
+	*out = *in
+	if in.MissingKeys != nil {
+		in, out := &in.MissingKeys, &out.MissingKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvalidKeys != nil {
+		in, out := &in.InvalidKeys, &out.InvalidKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReferenceStatus.
+func (in *SecretReferenceStatus) DeepCopy() *SecretReferenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReferenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WildWestQuota) DeepCopyInto(out *WildWestQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WildWestQuota.
+func (in *WildWestQuota) DeepCopy() *WildWestQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(WildWestQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WildWestQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WildWestQuotaList) DeepCopyInto(out *WildWestQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WildWestQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WildWestQuotaList.
+func (in *WildWestQuotaList) DeepCopy() *WildWestQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(WildWestQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WildWestQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WildWestQuotaSpec) DeepCopyInto(out *WildWestQuotaSpec) {
+	*out = *in
+	if in.MaxCowboys != nil {
+		in, out := &in.MaxCowboys, &out.MaxCowboys
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxArmamentDamage != nil {
+		in, out := &in.MaxArmamentDamage, &out.MaxArmamentDamage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLoadoutPieces != nil {
+		in, out := &in.MaxLoadoutPieces, &out.MaxLoadoutPieces
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WildWestQuotaSpec.
+func (in *WildWestQuotaSpec) DeepCopy() *WildWestQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WildWestQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WildWestQuotaStatus) DeepCopyInto(out *WildWestQuotaStatus) {
+	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make([]WildWestQuotaUsage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WildWestQuotaStatus.
+func (in *WildWestQuotaStatus) DeepCopy() *WildWestQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WildWestQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WildWestQuotaUsage) DeepCopyInto(out *WildWestQuotaUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WildWestQuotaUsage.
+func (in *WildWestQuotaUsage) DeepCopy() *WildWestQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(WildWestQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
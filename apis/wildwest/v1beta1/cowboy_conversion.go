@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// intentSeparators are the characters that end the action, the same set as
+// [[:space:]] in the CEL rules.
+const intentSeparators = " \t\n\v\f\r"
+
+// ParseIntent splits a v1alpha1 intent string into its action and target.
+// The action is the first word, ended by any whitespace; the target is the
+// remainder with surrounding whitespace trimmed. An empty string yields nil.
+//
+// This mirrors the CEL rules in the kcp APIConversion so objects converted
+// by the server and by Go clients agree.
+func ParseIntent(s string) *Intent {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	i := strings.IndexAny(s, intentSeparators)
+	if i < 0 {
+		return &Intent{Action: s}
+	}
+	return &Intent{Action: s[:i], Target: strings.TrimSpace(s[i:])}
+}
+
+// String returns the v1alpha1 string form of the intent.
+func (i *Intent) String() string {
+	if i == nil {
+		return ""
+	}
+	if i.Target == "" {
+		return i.Action
+	}
+	return i.Action + " " + i.Target
+}
+
+// ConvertTo converts this Cowboy to the hub version.
+func (src *Cowboy) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.Cowboy)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.CowboySpec{
+		Intent: src.Spec.Intent.String(),
+	}
+	for _, ref := range src.Spec.SecretRefs {
+		dstRef := v1alpha1.SecretReference{Name: ref.Name, Namespace: ref.Namespace}
+		for _, key := range ref.Keys {
+			dstRef.Keys = append(dstRef.Keys, v1alpha1.SecretKeyRequirement(key))
+		}
+		dst.Spec.SecretRefs = append(dst.Spec.SecretRefs, dstRef)
+	}
+	if src.Spec.ArmamentRef != nil {
+		dst.Spec.ArmamentRef = &v1alpha1.ArmamentReference{Name: src.Spec.ArmamentRef.Name}
+	}
+	for _, dep := range src.Spec.DependsOn {
+		dst.Spec.DependsOn = append(dst.Spec.DependsOn, v1alpha1.CowboyReference{Name: dep.Name})
+	}
+	dst.Spec.Schedule = src.Spec.Schedule
+	dst.Spec.ConcurrencyPolicy = v1alpha1.CowboyConcurrencyPolicy(src.Spec.ConcurrencyPolicy)
+	dst.Spec.StartingDeadlineSeconds = src.Spec.StartingDeadlineSeconds
+
+	dst.Status = v1alpha1.CowboyStatus{
+		ObservedGeneration: src.Status.ObservedGeneration,
+		Intent:             src.Status.Intent,
+		IntentGeneration:   src.Status.IntentGeneration,
+		Result:             src.Status.Result,
+		Phase:              v1alpha1.CowboyPhase(src.Status.Phase),
+		StartedAt:          src.Status.StartedAt,
+		CompletedAt:        src.Status.CompletedAt,
+		LastRunTime:        src.Status.LastRunTime,
+		NextRunTime:        src.Status.NextRunTime,
+		ScheduledFor:       src.Status.ScheduledFor,
+		Conditions:         src.Status.Conditions,
+	}
+	for _, action := range src.Status.History {
+		dst.Status.History = append(dst.Status.History, v1alpha1.CowboyAction(action))
+	}
+	for _, st := range src.Status.SecretRefs {
+		dst.Status.SecretRefs = append(dst.Status.SecretRefs, v1alpha1.SecretReferenceStatus(st))
+	}
+	if a := src.Status.Armament; a != nil {
+		dst.Status.Armament = &v1alpha1.ArmamentSnapshot{
+			Name:        a.Name,
+			DisplayName: a.DisplayName,
+			Kind:        v1alpha1.ArmamentKind(a.Kind),
+			Damage:      a.Damage,
+			Range:       a.Range,
+		}
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this Cowboy.
+func (dst *Cowboy) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.Cowboy)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = CowboySpec{
+		Intent: ParseIntent(src.Spec.Intent),
+	}
+	for _, ref := range src.Spec.SecretRefs {
+		dstRef := SecretReference{Name: ref.Name, Namespace: ref.Namespace}
+		for _, key := range ref.Keys {
+			dstRef.Keys = append(dstRef.Keys, SecretKeyRequirement(key))
+		}
+		dst.Spec.SecretRefs = append(dst.Spec.SecretRefs, dstRef)
+	}
+	if src.Spec.ArmamentRef != nil {
+		dst.Spec.ArmamentRef = &ArmamentReference{Name: src.Spec.ArmamentRef.Name}
+	}
+	for _, dep := range src.Spec.DependsOn {
+		dst.Spec.DependsOn = append(dst.Spec.DependsOn, CowboyReference{Name: dep.Name})
+	}
+	dst.Spec.Schedule = src.Spec.Schedule
+	dst.Spec.ConcurrencyPolicy = CowboyConcurrencyPolicy(src.Spec.ConcurrencyPolicy)
+	dst.Spec.StartingDeadlineSeconds = src.Spec.StartingDeadlineSeconds
+
+	dst.Status = CowboyStatus{
+		ObservedGeneration: src.Status.ObservedGeneration,
+		Intent:             src.Status.Intent,
+		IntentGeneration:   src.Status.IntentGeneration,
+		Result:             src.Status.Result,
+		Phase:              CowboyPhase(src.Status.Phase),
+		StartedAt:          src.Status.StartedAt,
+		CompletedAt:        src.Status.CompletedAt,
+		LastRunTime:        src.Status.LastRunTime,
+		NextRunTime:        src.Status.NextRunTime,
+		ScheduledFor:       src.Status.ScheduledFor,
+		Conditions:         src.Status.Conditions,
+	}
+	for _, action := range src.Status.History {
+		dst.Status.History = append(dst.Status.History, CowboyAction(action))
+	}
+	for _, st := range src.Status.SecretRefs {
+		dst.Status.SecretRefs = append(dst.Status.SecretRefs, SecretReferenceStatus(st))
+	}
+	if a := src.Status.Armament; a != nil {
+		dst.Status.Armament = &ArmamentSnapshot{
+			Name:        a.Name,
+			DisplayName: a.DisplayName,
+			Kind:        ArmamentKind(a.Kind),
+			Damage:      a.Damage,
+			Range:       a.Range,
+		}
+	}
+	return nil
+}
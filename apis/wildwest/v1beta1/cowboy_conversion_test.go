@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+func TestParseIntent(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *Intent
+	}{
+		{in: "", want: nil},
+		{in: " \t\n", want: nil},
+		{in: "ride", want: &Intent{Action: "ride"}},
+		{in: "ride the range", want: &Intent{Action: "ride", Target: "the range"}},
+		{in: "  ride   the range  ", want: &Intent{Action: "ride", Target: "the range"}},
+		{in: "ride\tthe range", want: &Intent{Action: "ride", Target: "the range"}},
+		{in: "ride\nthe range", want: &Intent{Action: "ride", Target: "the range"}},
+		{in: "ride\t \r\nthe\trange", want: &Intent{Action: "ride", Target: "the\trange"}},
+	}
+	for _, tt := range tests {
+		if got := ParseIntent(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseIntent(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIntentRoundTrip(t *testing.T) {
+	for _, in := range []*Intent{
+		nil,
+		{Action: "ride"},
+		{Action: "ride", Target: "the range"},
+		{Action: "ride", Target: "the\trange"},
+	} {
+		if got := ParseIntent(in.String()); !reflect.DeepEqual(got, in) {
+			t.Errorf("ParseIntent(%q) = %+v, want %+v", in.String(), got, in)
+		}
+	}
+	// An intent that is empty once trimmed converts to no intent, as the
+	// guarded CEL rule does.
+	for _, s := range []string{"", " ", "\t\n\v"} {
+		if got := ParseIntent(s); got != nil {
+			t.Errorf("ParseIntent(%q) = %+v, want nil", s, got)
+		}
+	}
+}
+
+func TestCowboyConversionRoundTrip(t *testing.T) {
+	now := metav1.Now()
+	deadline := int64(30)
+	cowboy := &Cowboy{
+		ObjectMeta: metav1.ObjectMeta{Name: "lonely-ranger", Generation: 3},
+		Spec: CowboySpec{
+			Intent: &Intent{Action: "ride", Target: "the range"},
+			SecretRefs: []SecretReference{{
+				Name: "creds", Namespace: "default",
+				Keys: []SecretKeyRequirement{{Name: "token", Pattern: "^[a-z]+$"}},
+			}},
+			ArmamentRef:             &ArmamentReference{Name: "colt-saa"},
+			DependsOn:               []CowboyReference{{Name: "sidekick"}},
+			Schedule:                "0 * * * *",
+			ConcurrencyPolicy:       CowboyConcurrencyReplace,
+			StartingDeadlineSeconds: &deadline,
+		},
+		Status: CowboyStatus{
+			ObservedGeneration: 3,
+			Intent:             "ride the range",
+			IntentGeneration:   3,
+			Result:             "Rode the range",
+			Phase:              CowboyPhaseSucceeded,
+			StartedAt:          &now,
+			CompletedAt:        &now,
+			LastRunTime:        &now,
+			NextRunTime:        &now,
+			ScheduledFor:       "0 * * * *",
+			History:            []CowboyAction{{Intent: "ride", Result: "Rode", Generation: 2, StartedAt: &now}},
+			SecretRefs:         []SecretReferenceStatus{{Name: "creds", Namespace: "default", Resolved: true}},
+			Armament:           &ArmamentSnapshot{Name: "colt-saa", DisplayName: "Colt SAA", Kind: "revolver", Damage: 50, Range: 50},
+			Conditions:         []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Succeeded", LastTransitionTime: now}},
+		},
+	}
+
+	hub := &v1alpha1.Cowboy{}
+	if err := cowboy.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if hub.Spec.Intent != "ride the range" {
+		t.Errorf("hub intent = %q, want %q", hub.Spec.Intent, "ride the range")
+	}
+	got := &Cowboy{}
+	if err := got.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	if !reflect.DeepEqual(got, cowboy) {
+		t.Errorf("round trip changed the cowboy:\n got: %+v\nwant: %+v", got, cowboy)
+	}
+}
@@ -17,14 +17,20 @@ limitations under the License.
 package main
 
 import (
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/spf13/pflag"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -34,6 +40,9 @@ import (
 
 	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
 	armamentsync "github.com/platform-mesh/provider-quickstart/operator/armament-sync"
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+	"github.com/platform-mesh/provider-quickstart/pkg/external/file"
+	externalhttp "github.com/platform-mesh/provider-quickstart/pkg/external/http"
 	"github.com/platform-mesh/provider-quickstart/pkg/external/static"
 )
 
@@ -47,24 +56,110 @@ func main() {
 	ctx := signals.SetupSignalHandler()
 	entryLog := log.Log.WithName("entrypoint")
 
-	var syncInterval time.Duration
+	var (
+		syncInterval time.Duration
+		pageSize     int
+		fullResync   time.Duration
+		triggerAddr  string
+		triggerFile  string
+		source       string
+		httpOpts     externalhttp.Options
+		httpFields   map[string]string
+		filePath     string
+		catalogCM    types.NamespacedName
+		catalogKey   string
+	)
 	pflag.DurationVar(&syncInterval, "sync-interval", 30*time.Second, "How often to reconcile the armament catalog against the external source")
+	pflag.DurationVar(&fullResync, "full-resync-interval", armamentsync.DefaultFullResyncInterval, "How often a catalog source with a change feed is listed in full instead of only reading its changes")
+	pflag.IntVar(&pageSize, "page-size", armamentsync.DefaultPageSize, "Page size requested from catalog sources that paginate")
+	pflag.StringVar(&triggerAddr, "trigger-bind-address", "", "Address to serve the HMAC-signed sync trigger endpoint on; empty disables it")
+	pflag.StringVar(&triggerFile, "trigger-secret-file", "", "File holding the shared HMAC secret for the sync trigger endpoint")
+	pflag.StringVar(&source, "source", "static", "External catalog source: static, http, file or configmap")
+	pflag.StringVar(&httpOpts.URL, "http-url", "", "Catalog endpoint for --source=http")
+	pflag.DurationVar(&httpOpts.Timeout, "http-timeout", externalhttp.DefaultTimeout, "Timeout for a single catalog request")
+	pflag.StringVar(&httpOpts.CAFile, "http-ca-file", "", "PEM bundle to verify the catalog endpoint's certificate instead of the system roots")
+	pflag.StringVar(&httpOpts.BearerTokenFile, "http-bearer-token-file", "", "File holding a bearer token for the catalog endpoint")
+	pflag.StringVar(&httpOpts.BasicAuthUsernameFile, "http-basic-auth-username-file", "", "File holding the basic auth username for the catalog endpoint")
+	pflag.StringVar(&httpOpts.BasicAuthPasswordFile, "http-basic-auth-password-file", "", "File holding the basic auth password for the catalog endpoint")
+	pflag.StringVar(&httpOpts.ItemsPath, "http-items-path", "", "Dot-separated path to the item array in the catalog response; empty if the response is the array")
+	pflag.StringToStringVar(&httpFields, "http-field-mapping", nil, "Overrides of the JSON path per armament field, e.g. externalID=sku,damage=stats.damage")
+	pflag.StringVar(&httpOpts.CursorParam, "http-cursor-param", "", "Query parameter carrying the page cursor; empty if the endpoint does not paginate")
+	pflag.StringVar(&httpOpts.LimitParam, "http-limit-param", "", "Query parameter carrying the page size")
+	pflag.StringVar(&httpOpts.NextCursorPath, "http-next-cursor-path", "", "Dot-separated path to the next page's cursor in the catalog response")
+	pflag.StringVar(&httpOpts.ChangesURL, "http-changes-url", "", "Change feed endpoint; when set, only changes are synced between full resyncs")
+	pflag.StringVar(&httpOpts.SinceParam, "http-since-param", externalhttp.DefaultSinceParam, "Query parameter carrying the watermark on change feed requests")
+	pflag.StringVar(&filePath, "file-path", "", "YAML, JSON or CSV catalog file for --source=file")
+	pflag.StringVar(&catalogCM.Namespace, "configmap-namespace", "default", "Namespace of the catalog ConfigMap in the provider workspace for --source=configmap")
+	pflag.StringVar(&catalogCM.Name, "configmap-name", "", "Name of the catalog ConfigMap in the provider workspace for --source=configmap")
+	pflag.StringVar(&catalogKey, "configmap-key", "catalog.yaml", "Key holding the catalog in the ConfigMap; its extension selects the format")
 	pflag.Parse()
 
 	cfg := ctrl.GetConfigOrDie()
 
-	mgr, err := manager.New(cfg, manager.Options{
+	opts := manager.Options{
 		Scheme:                 scheme.Scheme,
 		HealthProbeBindAddress: ":8081",
 		Metrics: metricsserver.Options{
 			BindAddress: ":9081",
 		},
-	})
+	}
+	if source == "configmap" {
+		// Cache the catalog ConfigMap only, not every ConfigMap in the
+		// provider workspace.
+		opts.Cache.ByObject = map[client.Object]cache.ByObject{
+			&corev1.ConfigMap{}: {
+				Namespaces: map[string]cache.Config{catalogCM.Namespace: {}},
+				Field:      fields.OneTermEqualSelector("metadata.name", catalogCM.Name),
+			},
+		}
+	}
+
+	mgr, err := manager.New(cfg, opts)
 	if err != nil {
 		entryLog.Error(err, "unable to set up manager")
 		os.Exit(1)
 	}
 
+	var src external.Client
+	switch source {
+	case "static":
+		src = static.New()
+	case "http":
+		httpOpts.Fields = httpFields
+		if httpOpts.ChangesURL != "" {
+			feedSource, err := externalhttp.NewFeed(httpOpts)
+			if err != nil {
+				entryLog.Error(err, "unable to set up http catalog source")
+				os.Exit(1)
+			}
+			src = feedSource
+			break
+		}
+		httpSource, err := externalhttp.New(httpOpts)
+		if err != nil {
+			entryLog.Error(err, "unable to set up http catalog source")
+			os.Exit(1)
+		}
+		src = httpSource
+	case "file":
+		fileSource, err := file.New(filePath)
+		if err != nil {
+			entryLog.Error(err, "unable to set up file catalog source")
+			os.Exit(1)
+		}
+		src = fileSource
+	case "configmap":
+		cmSource, err := file.NewConfigMap(mgr.GetClient(), mgr.GetCache(), catalogCM, catalogKey)
+		if err != nil {
+			entryLog.Error(err, "unable to set up configmap catalog source")
+			os.Exit(1)
+		}
+		src = cmSource
+	default:
+		entryLog.Error(nil, "unknown catalog source", "source", source)
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		entryLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -75,16 +170,34 @@ func main() {
 	}
 
 	syncer := &armamentsync.Syncer{
-		Client:   mgr.GetClient(),
-		Source:   static.New(),
-		Interval: syncInterval,
+		Client:             mgr.GetClient(),
+		Source:             src,
+		Interval:           syncInterval,
+		PageSize:           pageSize,
+		FullResyncInterval: fullResync,
 	}
 	if err := syncer.AddToManager(mgr); err != nil {
 		entryLog.Error(err, "unable to add armament syncer")
 		os.Exit(1)
 	}
 
-	entryLog.Info("Starting armament-sync manager", "interval", syncInterval)
+	if triggerAddr != "" {
+		if triggerFile == "" {
+			entryLog.Error(nil, "--trigger-secret-file is required with --trigger-bind-address")
+			os.Exit(1)
+		}
+		mux := http.NewServeMux()
+		mux.Handle(armamentsync.TriggerPath, &armamentsync.TriggerHandler{Syncer: syncer, SecretFile: triggerFile})
+		if err := mgr.Add(&manager.Server{
+			Name:   "trigger",
+			Server: &http.Server{Addr: triggerAddr, Handler: mux, ReadHeaderTimeout: 10 * time.Second},
+		}); err != nil {
+			entryLog.Error(err, "unable to add sync trigger server")
+			os.Exit(1)
+		}
+	}
+
+	entryLog.Info("Starting armament-sync manager", "interval", syncInterval, "source", source)
 	if err := mgr.Start(ctx); err != nil {
 		entryLog.Error(err, "manager exited with error")
 		os.Exit(1)
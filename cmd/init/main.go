@@ -38,6 +38,8 @@ func main() {
 		seedWorkspaces  bool
 		parentWorkspace string
 		workspaceSpecs  []string
+		webhookURL      string
+		webhookCAFile   string
 	)
 
 	pflag.StringVar(&kubeconfig, "kubeconfig", os.Getenv("KUBECONFIG"), "Path to kubeconfig file")
@@ -45,11 +47,16 @@ func main() {
 	pflag.BoolVar(&seedWorkspaces, "seed-workspaces", false, "Create the provider workspace hierarchy from the kubeconfig before bootstrapping. Requires an admin kubeconfig pointing at the kcp front-proxy.")
 	pflag.StringVar(&parentWorkspace, "parent-workspace", "root", "Absolute path of the parent workspace under which --workspace entries are created (only used with --seed-workspaces).")
 	pflag.StringSliceVar(&workspaceSpecs, "workspace", []string{"providers=root:providers", "quickstart=root:provider"}, "Workspace to create when --seed-workspaces is set, formatted as <name>=<type-path>:<type-name>. Repeat (or comma-separate) for nested workspaces in parent-first order. The final entry is the workspace bootstrapped into.")
+	pflag.StringVar(&webhookURL, "webhook-url", "", "Register the Cowboy validating webhook in the provider workspace, served at this URL (e.g. https://wildwest-controller-webhook.platform-mesh-system:9443/validate-wildwest-platform-mesh-io-v1alpha1-cowboy). Leave empty to skip webhook registration.")
+	pflag.StringVar(&webhookCAFile, "webhook-ca-file", "", "Path to the PEM CA bundle that signed the webhook serving certificate (required with --webhook-url).")
 	pflag.Parse()
 
 	if kubeconfig == "" {
 		klog.Fatal("--kubeconfig is required or set KUBECONFIG environment variable")
 	}
+	if webhookURL != "" && webhookCAFile == "" {
+		klog.Fatal("--webhook-ca-file is required when --webhook-url is set")
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
@@ -86,5 +93,15 @@ func main() {
 		klog.Fatal("Failed to bootstrap", "err", err)
 	}
 
+	if webhookURL != "" {
+		caBundle, err := os.ReadFile(webhookCAFile)
+		if err != nil {
+			klog.Fatal("Failed to read webhook CA bundle", "err", err)
+		}
+		if err := bootstrap.BootstrapWebhook(ctx, config, webhookURL, caBundle); err != nil {
+			klog.Fatal("Failed to bootstrap validating webhook", "err", err)
+		}
+	}
+
 	logger.Info("Bootstrap completed successfully")
 }
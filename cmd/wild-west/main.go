@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
 
@@ -40,6 +41,7 @@ import (
 	"github.com/kcp-dev/multicluster-provider/apiexport"
 
 	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+	wildwestv1beta1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1beta1"
 	wildwest "github.com/platform-mesh/provider-quickstart/operator/wild-west"
 )
 
@@ -48,6 +50,7 @@ func init() {
 	runtime.Must(tenancyv1alpha1.AddToScheme(scheme.Scheme))
 	runtime.Must(apisv1alpha1.AddToScheme(scheme.Scheme))
 	runtime.Must(wildwestv1alpha1.AddToScheme(scheme.Scheme))
+	runtime.Must(wildwestv1beta1.AddToScheme(scheme.Scheme))
 }
 
 func main() {
@@ -57,11 +60,17 @@ func main() {
 	entryLog := log.Log.WithName("entrypoint")
 
 	var (
-		endpointSlice string
-		provider      *apiexport.Provider
+		endpointSlice  string
+		enableWebhook  bool
+		webhookPort    int
+		webhookCertDir string
+		provider       *apiexport.Provider
 	)
 
 	pflag.StringVar(&endpointSlice, "endpointslice", "wildwest.platform-mesh.io", "Set the APIExportEndpointSlice name to watch")
+	pflag.BoolVar(&enableWebhook, "enable-webhook", false, "Serve the Cowboy validating webhook. Register it in the provider workspace with the init binary's --webhook-url.")
+	pflag.IntVar(&webhookPort, "webhook-port", 9443, "Port the validating webhook server listens on")
+	pflag.StringVar(&webhookCertDir, "webhook-cert-dir", "/tmp/k8s-webhook-server/serving-certs", "Directory containing tls.crt and tls.key for the webhook server")
 	pflag.Parse()
 
 	cfg := ctrl.GetConfigOrDie()
@@ -74,6 +83,12 @@ func main() {
 			BindAddress: ":9080",
 		},
 	}
+	if enableWebhook {
+		opts.WebhookServer = webhook.NewServer(webhook.Options{
+			Port:    webhookPort,
+			CertDir: webhookCertDir,
+		})
+	}
 
 	var err error
 	provider, err = apiexport.New(cfg, endpointSlice, apiexport.Options{})
@@ -98,12 +113,41 @@ func main() {
 	}
 
 	// Setup Cowboy controller
-	cowboyReconciler := &wildwest.CowboyReconciler{}
+	// Providers register handlers for their own intent keywords here;
+	// anything unregistered falls back to wildwest.DefaultIntentHandler.
+	cowboyReconciler := &wildwest.CowboyReconciler{
+		Intents: wildwest.NewIntentRegistry(),
+	}
 	if err := cowboyReconciler.SetupWithManager(mgr); err != nil {
 		entryLog.Error(err, "failed to setup cowboy controller")
 		os.Exit(1)
 	}
 
+	// Setup WildWestQuota usage controller
+	if err := (&wildwest.QuotaUsageReconciler{}).SetupWithManager(mgr); err != nil {
+		entryLog.Error(err, "failed to setup wildwest quota usage controller")
+		os.Exit(1)
+	}
+
+	// Setup Cowboy inventory controller
+	if err := (&wildwest.InventoryReconciler{}).SetupWithManager(mgr); err != nil {
+		entryLog.Error(err, "failed to setup cowboy inventory controller")
+		os.Exit(1)
+	}
+
+	// Setup Loadout controller
+	if err := (&wildwest.LoadoutReconciler{}).SetupWithManager(mgr); err != nil {
+		entryLog.Error(err, "failed to setup loadout controller")
+		os.Exit(1)
+	}
+
+	if enableWebhook {
+		if err := (&wildwest.CowboyValidator{}).SetupWebhookWithManager(mgr); err != nil {
+			entryLog.Error(err, "failed to setup cowboy webhook")
+			os.Exit(1)
+		}
+	}
+
 	entryLog.Info("Starting manager")
 	if err := mgr.Start(ctx); err != nil {
 		entryLog.Error(err, "unable to run manager")
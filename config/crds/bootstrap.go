@@ -24,6 +24,11 @@ import (
 // itself (not exposed via APIExport with crd:{} storage). Armaments are
 // stored in the provider workspace and replicated to consumers as read-only
 // via a CachedResource, so the provider workspace needs the real CRD.
+// WildWestQuotas, CowboyInventories and the ArmamentCatalog are
+// provider-only and are not exported at all.
 //
+//go:embed wildwest.platform-mesh.io_armamentcatalogs.yaml
 //go:embed wildwest.platform-mesh.io_armaments.yaml
+//go:embed wildwest.platform-mesh.io_cowboyinventories.yaml
+//go:embed wildwest.platform-mesh.io_wildwestquotas.yaml
 var ProviderFS embed.FS
@@ -23,12 +23,13 @@ package armamentsync
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -43,85 +44,168 @@ const managedByLabel = "wildwest.platform-mesh.io/managed-by"
 
 const managedByValue = "armament-sync"
 
+// DefaultPageSize is the page size requested from sources implementing
+// external.Pager when Syncer.PageSize is not set.
+const DefaultPageSize = 500
+
 // Syncer projects an external catalog onto Armament custom resources.
 type Syncer struct {
 	Client   client.Client
 	Source   external.Client
 	Interval time.Duration
+
+	// PageSize is the page size requested from sources implementing
+	// external.Pager. Zero means DefaultPageSize.
+	PageSize int
+
+	// FullResyncInterval is how often sources implementing
+	// external.DeltaClient are listed in full instead of only reading their
+	// changes. Zero means DefaultFullResyncInterval.
+	FullResyncInterval time.Duration
+
+	triggers chan struct{}
 }
 
 // AddToManager registers the syncer's tick loop with the manager. Unlike a
-// standard reconciler, this controller is driven entirely by a timer; there
-// is no watch on Armament because the source of truth is external.
+// standard reconciler, this controller is driven by a timer, by the change
+// notifications of sources implementing external.Watcher and by Trigger;
+// there is no watch on Armament because the source of truth is external.
 func (s *Syncer) AddToManager(mgr manager.Manager) error {
 	if s.Interval <= 0 {
 		return fmt.Errorf("sync interval must be > 0")
 	}
+	s.triggers = make(chan struct{}, 1)
 	return mgr.Add(manager.RunnableFunc(s.run))
 }
 
+// Trigger asks for a sync to run right away, or as soon as the one in
+// progress is done. Triggers that arrive while one is pending are coalesced
+// into it, so a burst of calls runs at most one extra sync. It does nothing
+// before AddToManager.
+func (s *Syncer) Trigger() {
+	select {
+	case s.triggers <- struct{}{}:
+	default:
+	}
+}
+
 func (s *Syncer) run(ctx context.Context) error {
 	logger := log.FromContext(ctx).WithName("armament-sync")
 	logger.Info("starting armament sync loop", "interval", s.Interval)
 
+	// Sources that notice their own changes trigger a sync right away. The
+	// watch starts before the initial sync so no change slips between them.
+	var changes <-chan struct{}
+	if w, ok := s.Source.(external.Watcher); ok {
+		ch, err := w.Watch(ctx)
+		if err != nil {
+			return fmt.Errorf("watch external source: %w", err)
+		}
+		changes = ch
+	}
+
 	// Run an initial sync immediately so the catalog appears without
 	// waiting a full interval after startup.
 	if err := s.syncOnce(ctx); err != nil {
 		logger.Error(err, "initial armament sync failed")
 	}
 
-	return wait.PollUntilContextCancel(ctx, s.Interval, false, func(ctx context.Context) (bool, error) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case _, ok := <-changes:
+			if !ok {
+				changes = nil
+				continue
+			}
+			logger.V(1).Info("external source changed, syncing now")
+			ticker.Reset(s.Interval)
+		case <-s.triggers:
+			logger.V(1).Info("sync triggered, syncing now")
+			ticker.Reset(s.Interval)
+		}
 		if err := s.syncOnce(ctx); err != nil {
 			logger.Error(err, "armament sync iteration failed")
 		}
-		return false, nil
-	})
+	}
 }
 
 func (s *Syncer) syncOnce(ctx context.Context) error {
+	if src, ok := s.Source.(external.DeltaClient); ok {
+		return s.syncDelta(ctx, src)
+	}
+	return s.fullSync(ctx, "")
+}
+
+// fullSync lists the complete catalog, upserts every item and deletes the
+// managed armaments that are no longer listed. The run is then recorded on
+// the ArmamentCatalog together with watermark, the change feed position the
+// listing started from.
+func (s *Syncer) fullSync(ctx context.Context, watermark string) error {
 	logger := log.FromContext(ctx).WithName("armament-sync")
 
-	desired, err := s.Source.List(ctx)
+	// Items are upserted page by page and only their external IDs are kept,
+	// so memory grows with the number of IDs rather than with the items.
+	desiredExternalIDs := map[string]struct{}{}
+	pageSize := s.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	err := external.ForEachPage(ctx, s.Source, pageSize, func(items []external.Armament) error {
+		for _, d := range items {
+			desiredExternalIDs[d.ExternalID] = struct{}{}
+			if err := s.upsert(ctx, d); err != nil {
+				logger.Error(err, "upsert armament", "externalID", d.ExternalID)
+			}
+		}
+		return nil
+	})
 	if err != nil {
+		// Items on the pages that were not listed would look deleted, so
+		// nothing is deleted unless the listing completed.
 		return fmt.Errorf("list from external source: %w", err)
 	}
 
+	// The managed armaments are served from the informer cache, which holds
+	// them anyway.
 	existing := &wildwestv1alpha1.ArmamentList{}
 	if err := s.Client.List(ctx, existing, client.MatchingLabels{managedByLabel: managedByValue}); err != nil {
 		return fmt.Errorf("list managed armaments: %w", err)
 	}
 
-	existingByExternalID := make(map[string]*wildwestv1alpha1.Armament, len(existing.Items))
 	for i := range existing.Items {
-		a := &existing.Items[i]
-		existingByExternalID[a.Spec.ExternalID] = a
-	}
-
-	desiredExternalIDs := make(map[string]struct{}, len(desired))
-	for _, d := range desired {
-		desiredExternalIDs[d.ExternalID] = struct{}{}
-		if err := s.upsert(ctx, d); err != nil {
-			logger.Error(err, "upsert armament", "externalID", d.ExternalID)
-		}
-	}
-
-	for externalID, obj := range existingByExternalID {
-		if _, kept := desiredExternalIDs[externalID]; kept {
+		obj := &existing.Items[i]
+		if _, kept := desiredExternalIDs[obj.Spec.ExternalID]; kept {
 			continue
 		}
 		if err := s.Client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
-			logger.Error(err, "delete stale armament", "name", obj.Name, "externalID", externalID)
+			logger.Error(err, "delete stale armament", "name", obj.Name, "externalID", obj.Spec.ExternalID)
 		}
 	}
 
-	logger.V(1).Info("armament sync complete", "desired", len(desired), "existing", len(existing.Items))
-	return nil
+	logger.V(1).Info("armament sync complete", "desired", len(desiredExternalIDs), "existing", len(existing.Items))
+	now := metav1.Now()
+	return s.updateCatalog(ctx, func(status *wildwestv1alpha1.ArmamentCatalogStatus) {
+		status.Watermark = watermark
+		status.LastSyncTime = &now
+		status.LastFullSyncTime = &now
+		status.Armaments = int32(len(desiredExternalIDs))
+	})
 }
 
 func (s *Syncer) upsert(ctx context.Context, src external.Armament) error {
+	desiredSpec, err := armamentSpecFromSource(src)
+	if err != nil {
+		return err
+	}
+
 	name := armamentName(src.ExternalID)
 	armament := &wildwestv1alpha1.Armament{}
-	err := s.Client.Get(ctx, types.NamespacedName{Name: name}, armament)
+	err = s.Client.Get(ctx, types.NamespacedName{Name: name}, armament)
 	switch {
 	case apierrors.IsNotFound(err):
 		armament = &wildwestv1alpha1.Armament{
@@ -129,7 +213,7 @@ func (s *Syncer) upsert(ctx context.Context, src external.Armament) error {
 				Name:   name,
 				Labels: map[string]string{managedByLabel: managedByValue},
 			},
-			Spec: armamentSpecFromSource(src),
+			Spec: desiredSpec,
 		}
 		if err := s.Client.Create(ctx, armament); err != nil {
 			return fmt.Errorf("create: %w", err)
@@ -139,9 +223,11 @@ func (s *Syncer) upsert(ctx context.Context, src external.Armament) error {
 		return fmt.Errorf("get: %w", err)
 	}
 
-	desiredSpec := armamentSpecFromSource(src)
-	if armament.Spec == desiredSpec && armament.Labels[managedByLabel] == managedByValue {
-		return s.stampSyncTime(ctx, armament)
+	// Unchanged armaments are not written at all: every write fans out
+	// through the CachedResource to all consumers. Freshness is recorded
+	// once per run on the ArmamentCatalog instead.
+	if equality.Semantic.DeepEqual(armament.Spec, desiredSpec) && armament.Labels[managedByLabel] == managedByValue {
+		return nil
 	}
 	if armament.Labels == nil {
 		armament.Labels = map[string]string{}
@@ -163,14 +249,39 @@ func (s *Syncer) stampSyncTime(ctx context.Context, armament *wildwestv1alpha1.A
 	return nil
 }
 
-func armamentSpecFromSource(src external.Armament) wildwestv1alpha1.ArmamentSpec {
-	return wildwestv1alpha1.ArmamentSpec{
+// armamentSpecFromSource maps an external catalog item onto an ArmamentSpec.
+// Only the attribute block matching the kind is carried over, so the spec
+// satisfies the CRD's discriminated-union rules whatever the source reports.
+func armamentSpecFromSource(src external.Armament) (wildwestv1alpha1.ArmamentSpec, error) {
+	kind := wildwestv1alpha1.ArmamentKind(strings.ToLower(strings.TrimSpace(src.Kind)))
+	spec := wildwestv1alpha1.ArmamentSpec{
 		ExternalID:  src.ExternalID,
 		DisplayName: src.DisplayName,
-		Kind:        src.Kind,
+		Kind:        kind,
 		Damage:      src.Damage,
 		Range:       src.Range,
 	}
+
+	switch {
+	case kind.IsFirearm():
+		if src.Firearm != nil {
+			spec.Firearm = &wildwestv1alpha1.FirearmAttributes{
+				Caliber:  src.Firearm.Caliber,
+				Capacity: src.Firearm.Capacity,
+			}
+		}
+	case kind == wildwestv1alpha1.ArmamentKindRope:
+		if src.Rope != nil {
+			spec.Rope = &wildwestv1alpha1.RopeAttributes{Length: src.Rope.Length}
+		}
+	case kind == wildwestv1alpha1.ArmamentKindBlade:
+		if src.Blade != nil {
+			spec.Blade = &wildwestv1alpha1.BladeAttributes{Length: src.Blade.Length}
+		}
+	default:
+		return wildwestv1alpha1.ArmamentSpec{}, fmt.Errorf("unsupported armament kind %q", src.Kind)
+	}
+	return spec, nil
 }
 
 // armamentName turns an opaque external identifier into a DNS-safe object
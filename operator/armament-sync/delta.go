@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armamentsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// DefaultFullResyncInterval is how often a source with a change feed is
+// listed in full when Syncer.FullResyncInterval is not set. Full syncs are
+// the safety net for changes the feed missed or that failed to apply.
+const DefaultFullResyncInterval = 10 * time.Minute
+
+// syncDelta applies the changes since the watermark persisted in the
+// ArmamentCatalog. It runs a full sync instead when there is no watermark
+// yet, when a full resync is due or when the source no longer has the
+// changes since the watermark.
+func (s *Syncer) syncDelta(ctx context.Context, src external.DeltaClient) error {
+	logger := log.FromContext(ctx).WithName("armament-sync")
+
+	catalog, err := s.getCatalog(ctx)
+	if err != nil {
+		return err
+	}
+	if reason := s.fullResyncReason(catalog, time.Now()); reason != "" {
+		logger.V(1).Info("running full armament sync", "reason", reason)
+		return s.fullDeltaSync(ctx, src)
+	}
+
+	delta, err := src.Changes(ctx, catalog.Status.Watermark)
+	if errors.Is(err, external.ErrWatermarkExpired) {
+		logger.Info("watermark expired, running full armament sync", "watermark", catalog.Status.Watermark)
+		return s.fullDeltaSync(ctx, src)
+	}
+	if err != nil {
+		return fmt.Errorf("read changes from external source: %w", err)
+	}
+
+	if err := s.applyChanges(ctx, delta.Changes); err != nil {
+		return err
+	}
+	logger.V(1).Info("armament delta sync complete", "changes", len(delta.Changes), "watermark", delta.Watermark)
+	now := metav1.Now()
+	return s.updateCatalog(ctx, func(status *wildwestv1alpha1.ArmamentCatalogStatus) {
+		status.Watermark = delta.Watermark
+		status.LastSyncTime = &now
+	})
+}
+
+// fullDeltaSync runs a full sync for a source with a change feed, starting
+// from the feed's current position. The position is read before listing, so
+// changes made while the catalog is listed are read again by the next delta
+// sync rather than lost.
+func (s *Syncer) fullDeltaSync(ctx context.Context, src external.DeltaClient) error {
+	start, err := src.Changes(ctx, "")
+	if err != nil {
+		return fmt.Errorf("read watermark from external source: %w", err)
+	}
+	return s.fullSync(ctx, start.Watermark)
+}
+
+// fullResyncReason returns why the next sync must list the complete
+// catalog, or an empty string if reading the changes is enough.
+func (s *Syncer) fullResyncReason(catalog *wildwestv1alpha1.ArmamentCatalog, now time.Time) string {
+	interval := s.FullResyncInterval
+	if interval <= 0 {
+		interval = DefaultFullResyncInterval
+	}
+	switch {
+	case catalog.Status.Watermark == "":
+		return "no watermark recorded"
+	case catalog.Status.LastFullSyncTime == nil:
+		return "no full sync recorded"
+	case now.Sub(catalog.Status.LastFullSyncTime.Time) >= interval:
+		return "full resync due"
+	}
+	return ""
+}
+
+// applyChanges applies changes in order. It stops at the first change that
+// fails so the watermark is not advanced past it; the remaining changes are
+// read again on the next sync. Items the CRD cannot represent are skipped,
+// as in a full sync, so that they do not hold up the feed.
+func (s *Syncer) applyChanges(ctx context.Context, changes []external.Change) error {
+	logger := log.FromContext(ctx).WithName("armament-sync")
+
+	for _, c := range changes {
+		externalID := c.Armament.ExternalID
+		if c.Deleted {
+			if err := s.deleteManaged(ctx, externalID); err != nil {
+				return fmt.Errorf("delete armament %s: %w", externalID, err)
+			}
+			continue
+		}
+		if _, err := armamentSpecFromSource(c.Armament); err != nil {
+			logger.Error(err, "skipping armament", "externalID", externalID)
+			continue
+		}
+		if err := s.upsert(ctx, c.Armament); err != nil {
+			return fmt.Errorf("upsert armament %s: %w", externalID, err)
+		}
+	}
+	return nil
+}
+
+// deleteManaged deletes the armament synced for externalID. Armaments not
+// managed by the syncer are left alone.
+func (s *Syncer) deleteManaged(ctx context.Context, externalID string) error {
+	armament := &wildwestv1alpha1.Armament{}
+	if err := s.Client.Get(ctx, types.NamespacedName{Name: armamentName(externalID)}, armament); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if armament.Labels[managedByLabel] != managedByValue || armament.Spec.ExternalID != externalID {
+		return nil
+	}
+	return client.IgnoreNotFound(s.Client.Delete(ctx, armament))
+}
+
+// getCatalog returns the ArmamentCatalog, or an empty one if it has not
+// been created yet.
+func (s *Syncer) getCatalog(ctx context.Context) (*wildwestv1alpha1.ArmamentCatalog, error) {
+	catalog := &wildwestv1alpha1.ArmamentCatalog{}
+	err := s.Client.Get(ctx, types.NamespacedName{Name: wildwestv1alpha1.ArmamentCatalogName}, catalog)
+	if apierrors.IsNotFound(err) {
+		catalog.Name = wildwestv1alpha1.ArmamentCatalogName
+		return catalog, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get armament catalog: %w", err)
+	}
+	return catalog, nil
+}
+
+// updateCatalog applies mutate to the ArmamentCatalog's status, creating the
+// catalog if it does not exist yet.
+func (s *Syncer) updateCatalog(ctx context.Context, mutate func(status *wildwestv1alpha1.ArmamentCatalogStatus)) error {
+	key := types.NamespacedName{Name: wildwestv1alpha1.ArmamentCatalogName}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		catalog := &wildwestv1alpha1.ArmamentCatalog{}
+		if err := s.Client.Get(ctx, key, catalog); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			catalog.Name = key.Name
+			if err := s.Client.Create(ctx, catalog); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					// The cache has not seen our own create yet; retry.
+					return apierrors.NewConflict(wildwestv1alpha1.GroupVersion.WithResource("armamentcatalogs").GroupResource(), key.Name, err)
+				}
+				return err
+			}
+		}
+
+		original := catalog.Status.DeepCopy()
+		mutate(&catalog.Status)
+		if equality.Semantic.DeepEqual(original, &catalog.Status) {
+			return nil
+		}
+		return s.Client.Status().Update(ctx, catalog)
+	})
+	if err != nil {
+		return fmt.Errorf("update armament catalog: %w", err)
+	}
+	return nil
+}
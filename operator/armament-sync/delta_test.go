@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armamentsync
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// fakeFeed is an external.DeltaClient whose watermark is the number of
+// changes recorded so far. Changes before oldest are no longer retained.
+type fakeFeed struct {
+	items  map[string]external.Armament
+	log    []external.Change
+	oldest int
+	lists  int
+}
+
+func newFakeFeed(items ...external.Armament) *fakeFeed {
+	f := &fakeFeed{items: map[string]external.Armament{}}
+	for _, a := range items {
+		f.items[a.ExternalID] = a
+	}
+	return f
+}
+
+func (f *fakeFeed) set(a external.Armament) {
+	f.items[a.ExternalID] = a
+	f.log = append(f.log, external.Change{Armament: a})
+}
+
+func (f *fakeFeed) remove(externalID string) {
+	delete(f.items, externalID)
+	f.log = append(f.log, external.Change{Armament: external.Armament{ExternalID: externalID}, Deleted: true})
+}
+
+func (f *fakeFeed) List(context.Context) ([]external.Armament, error) {
+	f.lists++
+	items := make([]external.Armament, 0, len(f.items))
+	for _, a := range f.items {
+		items = append(items, a)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ExternalID < items[j].ExternalID })
+	return items, nil
+}
+
+func (f *fakeFeed) Changes(_ context.Context, watermark string) (external.Delta, error) {
+	current := strconv.Itoa(len(f.log))
+	if watermark == "" {
+		return external.Delta{Watermark: current}, nil
+	}
+	since, err := strconv.Atoi(watermark)
+	if err != nil {
+		return external.Delta{}, err
+	}
+	if since < f.oldest {
+		return external.Delta{}, external.ErrWatermarkExpired
+	}
+	return external.Delta{Changes: f.log[since:], Watermark: current}, nil
+}
+
+func rope(externalID string, damage int32) external.Armament {
+	return external.Armament{ExternalID: externalID, Kind: "rope", Damage: damage, Rope: &external.RopeAttributes{Length: 10}}
+}
+
+func TestSyncDelta(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := wildwestv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&wildwestv1alpha1.Armament{}, &wildwestv1alpha1.ArmamentCatalog{}).
+		Build()
+	feed := newFakeFeed(rope("lasso", 5), rope("bullwhip", 8))
+	s := &Syncer{Client: c, Source: feed, Interval: time.Minute, FullResyncInterval: time.Hour}
+	ctx := context.Background()
+
+	sync := func(step string, wantLists int, wantWatermark string, wantArmaments ...string) {
+		t.Helper()
+		if err := s.syncOnce(ctx); err != nil {
+			t.Fatalf("%s: syncOnce: %v", step, err)
+		}
+		if feed.lists != wantLists {
+			t.Errorf("%s: source listed %d times, want %d", step, feed.lists, wantLists)
+		}
+		catalog := &wildwestv1alpha1.ArmamentCatalog{}
+		if err := c.Get(ctx, types.NamespacedName{Name: wildwestv1alpha1.ArmamentCatalogName}, catalog); err != nil {
+			t.Fatalf("%s: get catalog: %v", step, err)
+		}
+		if catalog.Status.Watermark != wantWatermark {
+			t.Errorf("%s: watermark = %q, want %q", step, catalog.Status.Watermark, wantWatermark)
+		}
+		armaments := &wildwestv1alpha1.ArmamentList{}
+		if err := c.List(ctx, armaments, client.MatchingLabels{managedByLabel: managedByValue}); err != nil {
+			t.Fatalf("%s: list armaments: %v", step, err)
+		}
+		var got []string
+		for _, a := range armaments.Items {
+			got = append(got, a.Name)
+		}
+		sort.Strings(got)
+		if len(got) != len(wantArmaments) {
+			t.Fatalf("%s: armaments = %v, want %v", step, got, wantArmaments)
+		}
+		for i := range got {
+			if got[i] != wantArmaments[i] {
+				t.Fatalf("%s: armaments = %v, want %v", step, got, wantArmaments)
+			}
+		}
+	}
+
+	// Without a watermark the first sync lists everything and records the
+	// feed position it started from.
+	sync("initial", 1, "0", "bullwhip", "lasso")
+
+	// Later syncs only apply the changes and advance the watermark.
+	feed.set(rope("lasso", 6))
+	feed.remove("bullwhip")
+	sync("delta", 1, "2", "lasso")
+	lasso := &wildwestv1alpha1.Armament{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "lasso"}, lasso); err != nil {
+		t.Fatal(err)
+	}
+	if lasso.Spec.Damage != 6 {
+		t.Errorf("lasso damage = %d, want 6", lasso.Spec.Damage)
+	}
+
+	// Nothing changed: the watermark stays and nothing is listed.
+	sync("no changes", 1, "2", "lasso")
+
+	// Once the feed drops the changes since the watermark, the syncer falls
+	// back to a full sync and starts over from the current position.
+	feed.set(rope("riata", 4))
+	feed.oldest = 3
+	sync("expired", 2, "3", "lasso", "riata")
+
+	// A due full resync lists everything even though the feed is intact.
+	s.FullResyncInterval = time.Nanosecond
+	sync("full resync", 3, "3", "lasso", "riata")
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armamentsync
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// TriggerPath is the path the sync trigger endpoint is served on.
+const TriggerPath = "/trigger"
+
+// SignatureHeader carries the HMAC-SHA256 of the request body under the
+// shared secret, hex encoded and prefixed with "sha256=". This is the scheme
+// GitHub and many other systems use to sign their webhooks.
+const SignatureHeader = "X-Signature-256"
+
+// maxTriggerBytes caps the size of a trigger request body.
+const maxTriggerBytes = 1 << 20
+
+// TriggerHandler serves the endpoint the external system calls when its
+// catalog changed. A request signed with the shared secret triggers a sync
+// right away; its body is only used to check the signature. Replaying a
+// signed request does no more than run an extra sync.
+type TriggerHandler struct {
+	Syncer *Syncer
+
+	// SecretFile holds the shared HMAC secret. It is read on every request
+	// so a rotated Secret mounted as a file is picked up without a restart.
+	SecretFile string
+}
+
+// ServeHTTP implements http.Handler.
+func (h *TriggerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := log.FromContext(r.Context()).WithName("armament-sync-trigger")
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxTriggerBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	secret, err := readSecret(h.SecretFile)
+	if err != nil {
+		logger.Error(err, "cannot verify sync trigger")
+		http.Error(w, "trigger secret unavailable", http.StatusInternalServerError)
+		return
+	}
+	if !validSignature(secret, body, r.Header.Get(SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	h.Syncer.Trigger()
+	logger.V(1).Info("armament sync triggered", "remote", r.RemoteAddr)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// readSecret reads the shared secret. Trailing newlines are dropped, as for
+// the catalog credentials.
+func readSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trigger secret: %w", err)
+	}
+	secret := strings.TrimRight(string(data), "\r\n")
+	if secret == "" {
+		return nil, fmt.Errorf("trigger secret file %s is empty", path)
+	}
+	return []byte(secret), nil
+}
+
+// validSignature reports whether header holds the HMAC-SHA256 of body under
+// secret. The comparison takes constant time.
+func validSignature(secret, body []byte, header string) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armamentsync
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidSignature(t *testing.T) {
+	const secret, body = "s3cret", `{"event":"catalog.updated"}`
+	valid := sign(secret, body)
+
+	tests := []struct {
+		name   string
+		body   string
+		header string
+		want   bool
+	}{
+		{name: "valid", body: body, header: valid, want: true},
+		{name: "empty header", body: body, header: ""},
+		{name: "wrong prefix", body: body, header: "sha1=" + strings.TrimPrefix(valid, "sha256=")},
+		{name: "missing prefix", body: body, header: strings.TrimPrefix(valid, "sha256=")},
+		{name: "bad hex", body: body, header: "sha256=" + strings.Repeat("zz", sha256.Size)},
+		{name: "odd length hex", body: body, header: valid[:len(valid)-1]},
+		{name: "truncated signature", body: body, header: valid[:len(valid)-2]},
+		{name: "longer signature", body: body, header: valid + "00"},
+		{name: "other body", body: body + " ", header: valid},
+		{name: "other secret", body: body, header: sign("other", body)},
+		{name: "uppercase hex", body: body, header: "sha256=" + strings.ToUpper(strings.TrimPrefix(valid, "sha256=")), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validSignature([]byte(secret), []byte(tt.body), tt.header); got != tt.want {
+				t.Errorf("validSignature(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTriggerHandler(t *testing.T) {
+	const secret, body = "s3cret", `{"event":"catalog.updated"}`
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	// A trailing newline, as left by editors, is not part of the secret.
+	if err := os.WriteFile(secretFile, []byte(secret+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		body          string
+		signature     string
+		wantStatus    int
+		wantTriggered bool
+	}{
+		{name: "signed", method: http.MethodPost, body: body, signature: sign(secret, body), wantStatus: http.StatusAccepted, wantTriggered: true},
+		{name: "unsigned", method: http.MethodPost, body: body, wantStatus: http.StatusUnauthorized},
+		{name: "wrong signature", method: http.MethodPost, body: body, signature: sign("other", body), wantStatus: http.StatusUnauthorized},
+		{name: "not a post", method: http.MethodGet, signature: sign(secret, ""), wantStatus: http.StatusMethodNotAllowed},
+		{name: "too large", method: http.MethodPost, body: strings.Repeat("x", maxTriggerBytes+1), wantStatus: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Syncer{triggers: make(chan struct{}, 1)}
+			h := &TriggerHandler{Syncer: s, SecretFile: secretFile}
+
+			req := httptest.NewRequest(tt.method, TriggerPath, strings.NewReader(tt.body))
+			if tt.signature != "" {
+				req.Header.Set(SignatureHeader, tt.signature)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if triggered := len(s.triggers) == 1; triggered != tt.wantTriggered {
+				t.Errorf("triggered = %v, want %v", triggered, tt.wantTriggered)
+			}
+		})
+	}
+}
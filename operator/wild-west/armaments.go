@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// armamentRefIndex indexes Cowboys by the name of the Armament they
+// reference, so catalog changes can be mapped back to Cowboys.
+const armamentRefIndex = "spec.armamentRef.name"
+
+// indexArmamentRef is the field indexer for armamentRefIndex.
+func indexArmamentRef(obj client.Object) []string {
+	cowboy, ok := obj.(*wildwestv1alpha1.Cowboy)
+	if !ok || cowboy.Spec.ArmamentRef == nil {
+		return nil
+	}
+	return []string{cowboy.Spec.ArmamentRef.Name}
+}
+
+// cowboysForArmament maps events on a catalog Armament, as seen through the
+// APIExport's virtual storage in the given cluster, to the Cowboys in that
+// cluster that reference it. Deletions by armament-sync are delivered too,
+// so referencing Cowboys flip to ArmamentNotFound.
+func cowboysForArmament(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, armament client.Object) []mcreconcile.Request {
+		cowboys := &wildwestv1alpha1.CowboyList{}
+		if err := cl.GetClient().List(ctx, cowboys, client.MatchingFields{armamentRefIndex: armament.GetName()}); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list cowboys referencing armament", "cluster", clusterName, "armament", armament.GetName())
+			return nil
+		}
+		return cowboyRequests(clusterName, cowboys.Items)
+	})
+}
+
+// resolveArmamentRef looks up the referenced Armament in the consumer
+// cluster, where the catalog is visible read-only through the APIExport's
+// virtual storage backed by the provider's CachedResource. On success the
+// consumer-relevant fields are copied into status.armament.
+func resolveArmamentRef(ctx context.Context, c client.Client, cowboy *wildwestv1alpha1.Cowboy) error {
+	ref := cowboy.Spec.ArmamentRef
+	if ref == nil {
+		cowboy.Status.Armament = nil
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionArmamentResolved, metav1.ConditionTrue, reasonNoArmamentRef, "Cowboy does not reference an Armament")
+		return nil
+	}
+
+	armament := &wildwestv1alpha1.Armament{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, armament); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get armament %s: %w", ref.Name, err)
+		}
+		cowboy.Status.Armament = nil
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionArmamentResolved, metav1.ConditionFalse, reasonArmamentNotFound,
+			fmt.Sprintf("Armament %q is not in the catalog", ref.Name))
+		return nil
+	}
+
+	cowboy.Status.Armament = &wildwestv1alpha1.ArmamentSnapshot{
+		Name:        armament.Name,
+		DisplayName: armament.Spec.DisplayName,
+		Kind:        armament.Spec.Kind,
+		Damage:      armament.Spec.Damage,
+		Range:       armament.Spec.Range,
+	}
+	setCondition(cowboy, wildwestv1alpha1.CowboyConditionArmamentResolved, metav1.ConditionTrue, reasonResolved,
+		fmt.Sprintf("Armament %q resolved", ref.Name))
+	return nil
+}
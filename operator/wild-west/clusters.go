@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+)
+
+// engagedClusters tracks the consumer clusters the multicluster manager is
+// engaged with. Added to the manager as a runnable, it is engaged with every
+// cluster the provider engages; the cluster's context ends when the provider
+// disengages from it. Both are reported as events carrying the cluster's
+// name, which a controller consumes through Source; a tracker without a
+// source only answers Has and List.
+type engagedClusters struct {
+	mu    sync.RWMutex
+	names map[string]context.Context
+
+	events  chan event.TypedGenericEvent[string]
+	stopped chan struct{}
+}
+
+func newEngagedClusters() *engagedClusters {
+	return &engagedClusters{
+		names:   map[string]context.Context{},
+		stopped: make(chan struct{}),
+	}
+}
+
+// Start implements manager.Runnable. There is nothing to run; the tracker
+// only needs to be added to the manager to be engaged.
+func (e *engagedClusters) Start(ctx context.Context) error {
+	<-ctx.Done()
+	close(e.stopped)
+	return nil
+}
+
+// Engage implements multicluster.Aware.
+func (e *engagedClusters) Engage(ctx context.Context, clusterName string, _ cluster.Cluster) error {
+	e.mu.Lock()
+	e.names[clusterName] = ctx
+	e.mu.Unlock()
+
+	go func() {
+		e.notify(clusterName)
+		<-ctx.Done()
+		e.mu.Lock()
+		// A cluster engaged again under the same name keeps its new entry.
+		current := e.names[clusterName] == ctx
+		if current {
+			delete(e.names, clusterName)
+		}
+		e.mu.Unlock()
+		if current {
+			e.notify(clusterName)
+		}
+	}()
+	return nil
+}
+
+// Has reports whether the cluster is engaged.
+func (e *engagedClusters) Has(clusterName string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.names[clusterName]
+	return ok
+}
+
+// List returns the names of the engaged clusters in order.
+func (e *engagedClusters) List() []string {
+	e.mu.RLock()
+	names := make([]string, 0, len(e.names))
+	for name := range e.names {
+		names = append(names, name)
+	}
+	e.mu.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+// Source returns a source that passes the name of every cluster that was
+// engaged or disengaged to h. A tracker has a single source, which must be
+// set up before the manager starts.
+func (e *engagedClusters) Source(h handler.TypedEventHandler[string, mcreconcile.Request]) source.TypedSource[mcreconcile.Request] {
+	e.events = make(chan event.TypedGenericEvent[string])
+	return source.TypedChannel(e.events, h)
+}
+
+// notify blocks until the source took the event or the manager stops.
+func (e *engagedClusters) notify(clusterName string) {
+	if e.events == nil {
+		return
+	}
+	select {
+	case e.events <- event.TypedGenericEvent[string]{Object: clusterName}:
+	case <-e.stopped:
+	}
+}
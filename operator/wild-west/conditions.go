@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// Condition reasons reported on Cowboy status.
+const (
+	reasonCompleted         = "Completed"
+	reasonPending           = "Pending"
+	reasonInProgress        = "InProgress"
+	reasonFailed            = "Failed"
+	reasonIdle              = "Idle"
+	reasonResolved          = "Resolved"
+	reasonNoSecretRefs      = "NoSecretRefs"
+	reasonSecretNotFound    = "SecretNotFound"
+	reasonSecretForbidden   = "SecretForbidden"
+	reasonSecretKeysMissing = "SecretKeysMissing"
+	reasonSecretKeysInvalid = "SecretKeysInvalid"
+	reasonNoArmamentRef     = "NoArmamentRef"
+	reasonArmamentNotFound  = "ArmamentNotFound"
+)
+
+// setCondition records a condition on a Cowboy or Loadout against its
+// current generation. meta.SetStatusCondition only bumps LastTransitionTime
+// when the status flips, so calling this on every reconcile does not churn
+// the object.
+func setCondition(obj client.Object, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	var conditions *[]metav1.Condition
+	switch obj := obj.(type) {
+	case *wildwestv1alpha1.Cowboy:
+		conditions = &obj.Status.Conditions
+	case *wildwestv1alpha1.Loadout:
+		conditions = &obj.Status.Conditions
+	default:
+		panic(fmt.Sprintf("setCondition: %T has no status conditions", obj))
+	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: obj.GetGeneration(),
+	})
+}
+
+// setReadyCondition summarizes the dependent conditions and the phase of
+// the current action into Ready. The first dependent condition that is False
+// blocks readiness and its reason is surfaced so that `kubectl get` explains
+// why the cowboy is not ready. A pending action that is Blocked surfaces the
+// unsatisfied dependency the same way.
+func setReadyCondition(cowboy *wildwestv1alpha1.Cowboy) {
+	for _, t := range []string{
+		wildwestv1alpha1.CowboyConditionSecretsResolved,
+		wildwestv1alpha1.CowboyConditionArmamentResolved,
+		wildwestv1alpha1.CowboyConditionWithinQuota,
+	} {
+		c := meta.FindStatusCondition(cowboy.Status.Conditions, t)
+		if c != nil && c.Status == metav1.ConditionFalse {
+			setCondition(cowboy, wildwestv1alpha1.CowboyConditionReady, metav1.ConditionFalse, c.Reason, c.Message)
+			return
+		}
+	}
+
+	switch cowboy.Status.Phase {
+	case "":
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionReady, metav1.ConditionTrue, reasonIdle, "Cowboy has no intent to carry out")
+	case wildwestv1alpha1.CowboyPhasePending:
+		if c := meta.FindStatusCondition(cowboy.Status.Conditions, wildwestv1alpha1.CowboyConditionBlocked); c != nil && c.Status == metav1.ConditionTrue {
+			setCondition(cowboy, wildwestv1alpha1.CowboyConditionReady, metav1.ConditionFalse, c.Reason, c.Message)
+			return
+		}
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionReady, metav1.ConditionFalse, reasonPending, fmt.Sprintf("Waiting to start %q", cowboy.Status.Intent))
+	case wildwestv1alpha1.CowboyPhaseRunning:
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionReady, metav1.ConditionFalse, reasonInProgress, cowboy.Status.Result)
+	case wildwestv1alpha1.CowboyPhaseFailed:
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionReady, metav1.ConditionFalse, reasonFailed, cowboy.Status.Result)
+	default:
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionReady, metav1.ConditionTrue, reasonCompleted, cowboy.Status.Result)
+	}
+}
@@ -19,12 +19,21 @@ package wildwest
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
@@ -36,15 +45,58 @@ import (
 // CowboyReconciler reconciles a Cowboy object
 type CowboyReconciler struct {
 	Manager mcmanager.Manager
+
+	// Intents routes each Cowboy's intent to the handler that carries it
+	// out. A nil registry sends every intent to DefaultIntentHandler.
+	Intents *IntentRegistry
+
+	// Teardown is called for a Cowboy that is being deleted, before its
+	// finalizer is removed. Leave it nil when there is nothing to release.
+	Teardown TeardownFunc
+
+	clusters *engagedClusters
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *CowboyReconciler) SetupWithManager(mgr mcmanager.Manager) error {
 	r.Manager = mgr
+	if r.Intents == nil {
+		r.Intents = NewIntentRegistry()
+	}
+
+	// The indexes are registered with every engaged cluster's cache, so the
+	// watches below can find referencing Cowboys per cluster.
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &wildwestv1alpha1.Cowboy{}, secretRefsIndex, indexSecretRefs); err != nil {
+		return fmt.Errorf("failed to index cowboys by secret reference: %w", err)
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &wildwestv1alpha1.Cowboy{}, armamentRefIndex, indexArmamentRef); err != nil {
+		return fmt.Errorf("failed to index cowboys by armament reference: %w", err)
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &wildwestv1alpha1.Cowboy{}, dependsOnIndex, indexDependsOn); err != nil {
+		return fmt.Errorf("failed to index cowboys by dependency: %w", err)
+	}
+
+	// The engaged clusters are tracked to find the workspaces a default
+	// WildWestQuota applies to.
+	r.clusters = newEngagedClusters()
+	if err := mgr.Add(r.clusters); err != nil {
+		return fmt.Errorf("failed to add cluster tracker: %w", err)
+	}
 
 	return mcbuilder.ControllerManagedBy(mgr).
 		Named("cowboy-controller").
 		For(&wildwestv1alpha1.Cowboy{}).
+		// Secrets are only watched to find the Cowboys to re-check, so only
+		// their metadata is cached; resolveSecretRefs reads the data uncached.
+		Watches(&corev1.Secret{}, cowboysForSecret, mcbuilder.OnlyMetadata).
+		Watches(&wildwestv1alpha1.Armament{}, cowboysForArmament).
+		Watches(&wildwestv1alpha1.Cowboy{}, cowboysForDependency).
+		// WildWestQuotas live in the provider workspace and are watched
+		// through the local manager. Usage updates do not change the limits,
+		// so only spec changes re-check the Cowboys.
+		WatchesRawSource(source.TypedKind(mgr.GetLocalManager().GetCache(), &wildwestv1alpha1.WildWestQuota{},
+			handler.TypedEnqueueRequestsFromMapFunc(r.cowboysForQuota),
+			predicate.TypedGenerationChangedPredicate[*wildwestv1alpha1.WildWestQuota]{})).
 		Complete(mcreconcile.Func(r.Reconcile))
 }
 
@@ -68,20 +120,175 @@ func (r *CowboyReconciler) Reconcile(ctx context.Context, req mcreconcile.Reques
 		return reconcile.Result{}, fmt.Errorf("failed to get cowboy: %w", err)
 	}
 
+	recorder := cl.GetEventRecorderFor("cowboy-controller")
+
+	if !cowboy.DeletionTimestamp.IsZero() {
+		log.Info("Finalizing Cowboy", "name", cowboy.Name)
+		return reconcile.Result{}, r.finalize(ctx, cl, recorder, cowboy)
+	}
+	if err := ensureFinalizer(ctx, cl, recorder, cowboy); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	log.Info("Reconciling Cowboy", "name", cowboy.Name, "intent", cowboy.Spec.Intent)
 
-	// Update status based on intent
-	if cowboy.Spec.Intent != "" && cowboy.Status.Result == "" {
-		cowboy.Status.Result = fmt.Sprintf("Yeehaw! %s completed", cowboy.Spec.Intent)
+	original := cowboy.Status.DeepCopy()
+
+	// Start a new action when the intent changed since the last one.
+	if intentChanged(cowboy) {
+		startAction(cowboy)
+	}
+	// Start a new run of the same intent when its schedule came due.
+	scheduleRequeue := runSchedule(recorder, cowboy, time.Now().UTC())
+
+	if err := resolveSecretRefs(ctx, cl.GetAPIReader(), cowboy); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := resolveArmamentRef(ctx, client, cowboy); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.checkCowboyQuota(ctx, cl, req.ClusterName, cowboy); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := resolveDependencies(ctx, client, cowboy); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	result, err := r.advanceAction(ctx, cl, recorder, cowboy)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if meta.IsStatusConditionFalse(cowboy.Status.Conditions, wildwestv1alpha1.CowboyConditionWithinQuota) {
+		requeueSooner(&result, cowboyQuotaRequeue)
+	}
+	requeueSooner(&result, scheduleRequeue)
+	setReadyCondition(cowboy)
+	cowboy.Status.ObservedGeneration = cowboy.Generation
+
+	if !equality.Semantic.DeepEqual(original, &cowboy.Status) {
 		if err := client.Status().Update(ctx, cowboy); err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to update cowboy status: %w", err)
 		}
-		log.Info("Updated Cowboy status", "result", cowboy.Status.Result)
+		log.Info("Updated Cowboy status", "result", cowboy.Status.Result, "observedGeneration", cowboy.Status.ObservedGeneration)
 	}
 
 	// Record an event
-	recorder := cl.GetEventRecorderFor("cowboy-controller")
 	recorder.Eventf(cowboy, corev1.EventTypeNormal, "Reconciled", "Cowboy %s reconciled", cowboy.Name)
 
-	return reconcile.Result{}, nil
+	return result, nil
+}
+
+// intentChanged reports whether spec.intent differs from the intent the
+// current result was produced for. The intent lives in the spec, so a change
+// always comes with a new generation; objects whose generation has already
+// been picked up are never re-run.
+func intentChanged(cowboy *wildwestv1alpha1.Cowboy) bool {
+	return cowboy.Generation != cowboy.Status.IntentGeneration &&
+		cowboy.Spec.Intent != cowboy.Status.Intent
+}
+
+// startAction moves the current action into the bounded history and makes
+// the intent from the spec the current one. It is also used to start each
+// run of a scheduled intent.
+func startAction(cowboy *wildwestv1alpha1.Cowboy) {
+	status := &cowboy.Status
+	if status.Intent != "" || status.Result != "" {
+		previous := wildwestv1alpha1.CowboyAction{
+			Intent:      status.Intent,
+			Result:      status.Result,
+			Generation:  status.IntentGeneration,
+			StartedAt:   status.StartedAt,
+			CompletedAt: status.CompletedAt,
+		}
+		status.History = append([]wildwestv1alpha1.CowboyAction{previous}, status.History...)
+		if len(status.History) > wildwestv1alpha1.CowboyHistoryLimit {
+			status.History = status.History[:wildwestv1alpha1.CowboyHistoryLimit]
+		}
+	}
+
+	status.Intent = cowboy.Spec.Intent
+	status.IntentGeneration = cowboy.Generation
+	status.Result = ""
+	status.Phase = ""
+	if status.Intent != "" {
+		status.Phase = wildwestv1alpha1.CowboyPhasePending
+	}
+	status.StartedAt = nil
+	status.CompletedAt = nil
+}
+
+// advanceAction moves the current action at most one phase forward and
+// returns when the cowboy should be looked at again. Each transition is
+// persisted before the next one happens, so consumers in the kcp workspace
+// observe Pending and Running even for handlers that finish immediately:
+//
+//	Pending -> Running: the handler is started and StartedAt is set, once
+//	                    the cowboy fits in its workspace's quota and is not
+//	                    blocked by spec.dependsOn.
+//	Running -> Running: the handler reports progress and is polled again
+//	                    after its RequeueAfter.
+//	Running -> Succeeded or Failed: CompletedAt is set and the handler is
+//	                    not called again until the intent changes.
+func (r *CowboyReconciler) advanceAction(ctx context.Context, cl cluster.Cluster, recorder record.EventRecorder, cowboy *wildwestv1alpha1.Cowboy) (reconcile.Result, error) {
+	status := &cowboy.Status
+	switch status.Phase {
+	case wildwestv1alpha1.CowboyPhaseSucceeded, wildwestv1alpha1.CowboyPhaseFailed:
+		return reconcile.Result{}, nil
+	case wildwestv1alpha1.CowboyPhaseRunning:
+	default:
+		if status.Intent == "" {
+			return reconcile.Result{}, nil
+		}
+		// Cowboys over their workspace's quota or waiting for a dependency
+		// stay Pending until they fit and their dependencies succeeded.
+		if meta.IsStatusConditionFalse(cowboy.Status.Conditions, wildwestv1alpha1.CowboyConditionWithinQuota) ||
+			meta.IsStatusConditionTrue(cowboy.Status.Conditions, wildwestv1alpha1.CowboyConditionBlocked) {
+			return reconcile.Result{}, nil
+		}
+		now := metav1.Now()
+		status.Phase = wildwestv1alpha1.CowboyPhaseRunning
+		status.StartedAt = &now
+		recorder.Eventf(cowboy, corev1.EventTypeNormal, "ActionStarted", "Cowboy %s started %q", cowboy.Name, status.Intent)
+		return reconcile.Result{RequeueAfter: phaseTransitionRequeue}, nil
+	}
+
+	result, err := r.Intents.Lookup(status.Intent).Handle(ctx, cl, cowboy)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("intent handler for %q failed: %w", IntentKeyword(status.Intent), err)
+	}
+	status.Result = result.Message
+
+	switch result.Outcome {
+	case IntentSucceeded:
+		completeAction(cowboy, wildwestv1alpha1.CowboyPhaseSucceeded)
+		recorder.Eventf(cowboy, corev1.EventTypeNormal, "ActionSucceeded", "Cowboy %s carried out %q", cowboy.Name, status.Intent)
+		return reconcile.Result{}, nil
+	case IntentFailed:
+		completeAction(cowboy, wildwestv1alpha1.CowboyPhaseFailed)
+		recorder.Eventf(cowboy, corev1.EventTypeWarning, "ActionFailed", "Cowboy %s failed to carry out %q: %s", cowboy.Name, status.Intent, status.Result)
+		return reconcile.Result{}, nil
+	default:
+		if result.RequeueAfter <= 0 {
+			result.RequeueAfter = defaultIntentRequeue
+		}
+		return reconcile.Result{RequeueAfter: result.RequeueAfter}, nil
+	}
+}
+
+// completeAction moves the current action into a terminal phase.
+func completeAction(cowboy *wildwestv1alpha1.Cowboy, phase wildwestv1alpha1.CowboyPhase) {
+	now := metav1.Now()
+	cowboy.Status.Phase = phase
+	cowboy.Status.CompletedAt = &now
+}
+
+// requeueSooner lowers result.RequeueAfter to after unless the result
+// already asks to be requeued sooner. A zero after leaves result unchanged.
+func requeueSooner(result *reconcile.Result, after time.Duration) {
+	if after <= 0 {
+		return
+	}
+	if result.RequeueAfter == 0 || after < result.RequeueAfter {
+		result.RequeueAfter = after
+	}
 }
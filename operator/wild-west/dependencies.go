@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// Condition reasons reported on the Blocked condition.
+const (
+	reasonNoDependencies        = "NoDependencies"
+	reasonDependenciesSucceeded = "DependenciesSucceeded"
+	reasonDependencyNotFound    = "DependencyNotFound"
+	reasonWaitingForDependency  = "WaitingForDependency"
+	reasonDependencyCycle       = "DependencyCycle"
+)
+
+// dependsOnIndex indexes Cowboys by the names of the Cowboys they depend
+// on, so a change to one Cowboy can be mapped back to its dependents.
+const dependsOnIndex = "spec.dependsOn.name"
+
+// indexDependsOn is the field indexer for dependsOnIndex.
+func indexDependsOn(obj client.Object) []string {
+	cowboy, ok := obj.(*wildwestv1alpha1.Cowboy)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(cowboy.Spec.DependsOn))
+	for _, dep := range cowboy.Spec.DependsOn {
+		names = append(names, dep.Name)
+	}
+	return names
+}
+
+// cowboysForDependency maps events on a Cowboy in the given cluster to the
+// Cowboys in that cluster that depend on it. Creations are delivered too, so
+// dependents waiting for a missing Cowboy are unblocked once it appears.
+func cowboysForDependency(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, dependency client.Object) []mcreconcile.Request {
+		cowboys := &wildwestv1alpha1.CowboyList{}
+		if err := cl.GetClient().List(ctx, cowboys, client.MatchingFields{dependsOnIndex: dependency.GetName()}); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list cowboys depending on cowboy", "cluster", clusterName, "cowboy", dependency.GetName())
+			return nil
+		}
+		return cowboyRequests(clusterName, cowboys.Items)
+	})
+}
+
+// resolveDependencies checks the Cowboys in spec.dependsOn and records the
+// outcome as the Blocked condition. The first dependency that has not
+// succeeded is named in the message; a cycle through this cowboy blocks it
+// for good until the spec changes.
+func resolveDependencies(ctx context.Context, c client.Client, cowboy *wildwestv1alpha1.Cowboy) error {
+	if len(cowboy.Spec.DependsOn) == 0 {
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionBlocked, metav1.ConditionFalse, reasonNoDependencies, "Cowboy has no dependencies")
+		return nil
+	}
+
+	cowboys := &wildwestv1alpha1.CowboyList{}
+	if err := c.List(ctx, cowboys); err != nil {
+		return fmt.Errorf("failed to list cowboys: %w", err)
+	}
+	byName := make(map[string]*wildwestv1alpha1.Cowboy, len(cowboys.Items))
+	for i := range cowboys.Items {
+		if cowboys.Items[i].DeletionTimestamp.IsZero() {
+			byName[cowboys.Items[i].Name] = &cowboys.Items[i]
+		}
+	}
+	byName[cowboy.Name] = cowboy
+
+	if cycle := findDependencyCycle(cowboy.Name, byName); cycle != nil {
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionBlocked, metav1.ConditionTrue, reasonDependencyCycle,
+			fmt.Sprintf("Dependencies form a cycle: %s", strings.Join(cycle, " -> ")))
+		return nil
+	}
+
+	for _, dep := range cowboy.Spec.DependsOn {
+		other, ok := byName[dep.Name]
+		if !ok {
+			setCondition(cowboy, wildwestv1alpha1.CowboyConditionBlocked, metav1.ConditionTrue, reasonDependencyNotFound,
+				fmt.Sprintf("Dependency %q does not exist", dep.Name))
+			return nil
+		}
+		if !dependencySucceeded(other) {
+			phase := string(other.Status.Phase)
+			if phase == "" {
+				phase = "Idle"
+			}
+			setCondition(cowboy, wildwestv1alpha1.CowboyConditionBlocked, metav1.ConditionTrue, reasonWaitingForDependency,
+				fmt.Sprintf("Waiting for dependency %q to succeed, it is %s", dep.Name, phase))
+			return nil
+		}
+	}
+
+	setCondition(cowboy, wildwestv1alpha1.CowboyConditionBlocked, metav1.ConditionFalse, reasonDependenciesSucceeded, "All dependencies succeeded")
+	return nil
+}
+
+// dependencySucceeded reports whether a Cowboy carried out the intent
+// currently in its spec successfully.
+func dependencySucceeded(cowboy *wildwestv1alpha1.Cowboy) bool {
+	return cowboy.Status.Phase == wildwestv1alpha1.CowboyPhaseSucceeded &&
+		cowboy.Status.Intent == cowboy.Spec.Intent
+}
+
+// findDependencyCycle returns the names along a dependency path that leads
+// from start back to itself, or nil when there is none. Cycles that do not
+// pass through start are not reported; the Cowboys on them never succeed,
+// so start waits on them instead.
+func findDependencyCycle(start string, cowboys map[string]*wildwestv1alpha1.Cowboy) []string {
+	visited := map[string]bool{start: true}
+	var visit func(name string, path []string) []string
+	visit = func(name string, path []string) []string {
+		cowboy, ok := cowboys[name]
+		if !ok {
+			return nil
+		}
+		for _, dep := range cowboy.Spec.DependsOn {
+			if dep.Name == start {
+				return append(path, start)
+			}
+			if visited[dep.Name] {
+				continue
+			}
+			visited[dep.Name] = true
+			if cycle := visit(dep.Name, append(path, dep.Name)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(start, []string{start})
+}
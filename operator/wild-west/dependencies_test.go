@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"reflect"
+	"testing"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+func TestFindDependencyCycle(t *testing.T) {
+	// graph maps each Cowboy to the names it depends on.
+	cowboys := func(graph map[string][]string) map[string]*wildwestv1alpha1.Cowboy {
+		out := make(map[string]*wildwestv1alpha1.Cowboy, len(graph))
+		for name, deps := range graph {
+			cowboy := &wildwestv1alpha1.Cowboy{}
+			cowboy.Name = name
+			for _, dep := range deps {
+				cowboy.Spec.DependsOn = append(cowboy.Spec.DependsOn, wildwestv1alpha1.CowboyReference{Name: dep})
+			}
+			out[name] = cowboy
+		}
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		start string
+		graph map[string][]string
+		want  []string
+	}{
+		{
+			name:  "no dependencies",
+			start: "a",
+			graph: map[string][]string{"a": nil},
+		},
+		{
+			name:  "chain without a cycle",
+			start: "a",
+			graph: map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil},
+		},
+		{
+			name:  "missing dependency",
+			start: "a",
+			graph: map[string][]string{"a": {"ghost"}},
+		},
+		{
+			name:  "depends on itself",
+			start: "a",
+			graph: map[string][]string{"a": {"a"}},
+			want:  []string{"a", "a"},
+		},
+		{
+			name:  "two cowboys",
+			start: "a",
+			graph: map[string][]string{"a": {"b"}, "b": {"a"}},
+			want:  []string{"a", "b", "a"},
+		},
+		{
+			name:  "cycle behind a dead end",
+			start: "a",
+			graph: map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d", "a"}, "d": nil},
+			want:  []string{"a", "c", "a"},
+		},
+		{
+			name:  "diamond without a cycle",
+			start: "a",
+			graph: map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}, "d": nil},
+		},
+		{
+			name:  "cycle not through start",
+			start: "a",
+			graph: map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findDependencyCycle(tt.start, cowboys(tt.graph))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findDependencyCycle(%q) = %v, want %v", tt.start, got, tt.want)
+			}
+		})
+	}
+}
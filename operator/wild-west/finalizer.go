@@ -0,0 +1,76 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// CowboyFinalizer is added to every Cowboy so the controller gets a chance
+// to release what it provisioned before the object disappears.
+const CowboyFinalizer = "wildwest.platform-mesh.io/finalizer"
+
+// TeardownFunc releases whatever the provider provisioned for a Cowboy. It
+// runs once the Cowboy is marked for deletion and may run more than once, so
+// it must be idempotent. Returning an error keeps the finalizer in place and
+// the teardown is retried.
+type TeardownFunc func(ctx context.Context, cl cluster.Cluster, cowboy *wildwestv1alpha1.Cowboy) error
+
+// ensureFinalizer adds CowboyFinalizer to a live Cowboy that does not carry
+// it yet.
+func ensureFinalizer(ctx context.Context, cl cluster.Cluster, recorder record.EventRecorder, cowboy *wildwestv1alpha1.Cowboy) error {
+	if !controllerutil.AddFinalizer(cowboy, CowboyFinalizer) {
+		return nil
+	}
+	if err := cl.GetClient().Update(ctx, cowboy); err != nil {
+		return fmt.Errorf("failed to add finalizer: %w", err)
+	}
+	recorder.Eventf(cowboy, corev1.EventTypeNormal, "FinalizerAdded", "Added finalizer %s", CowboyFinalizer)
+	return nil
+}
+
+// finalize runs the teardown step for a Cowboy that is being deleted and
+// then removes CowboyFinalizer so the deletion can complete.
+func (r *CowboyReconciler) finalize(ctx context.Context, cl cluster.Cluster, recorder record.EventRecorder, cowboy *wildwestv1alpha1.Cowboy) error {
+	if !controllerutil.ContainsFinalizer(cowboy, CowboyFinalizer) {
+		return nil
+	}
+
+	if r.Teardown != nil {
+		recorder.Eventf(cowboy, corev1.EventTypeNormal, "TeardownStarted", "Releasing resources for cowboy %s", cowboy.Name)
+		if err := r.Teardown(ctx, cl, cowboy); err != nil {
+			recorder.Eventf(cowboy, corev1.EventTypeWarning, "TeardownFailed", "Failed to release resources: %v", err)
+			return fmt.Errorf("teardown failed: %w", err)
+		}
+		recorder.Eventf(cowboy, corev1.EventTypeNormal, "TeardownCompleted", "Released resources for cowboy %s", cowboy.Name)
+	}
+
+	controllerutil.RemoveFinalizer(cowboy, CowboyFinalizer)
+	if err := cl.GetClient().Update(ctx, cowboy); err != nil {
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+	recorder.Eventf(cowboy, corev1.EventTypeNormal, "FinalizerRemoved", "Removed finalizer %s", CowboyFinalizer)
+	return nil
+}
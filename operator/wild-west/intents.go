@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// IntentOutcome is the state of an action as reported by an IntentHandler.
+type IntentOutcome string
+
+const (
+	// IntentInProgress means the action has not finished yet and the
+	// handler wants to be called again later.
+	IntentInProgress IntentOutcome = "InProgress"
+
+	// IntentSucceeded means the action finished successfully.
+	IntentSucceeded IntentOutcome = "Succeeded"
+
+	// IntentFailed means the action finished and cannot succeed without a
+	// change to the spec.
+	IntentFailed IntentOutcome = "Failed"
+)
+
+// defaultIntentRequeue is used when an in-progress handler does not ask for
+// a specific requeue interval.
+const defaultIntentRequeue = 10 * time.Second
+
+// phaseTransitionRequeue is how soon a cowboy is looked at again after its
+// action moved from Pending to Running.
+const phaseTransitionRequeue = time.Second
+
+// IntentResult is what an IntentHandler reports for a Cowboy's action.
+type IntentResult struct {
+	// Outcome is the state of the action.
+	Outcome IntentOutcome
+
+	// Message is recorded as the cowboy's result.
+	Message string
+
+	// RequeueAfter is how long to wait before calling the handler again
+	// while the action is in progress. Zero means defaultIntentRequeue.
+	RequeueAfter time.Duration
+}
+
+// IntentHandler carries out a Cowboy's intent. The reconciler calls Handle
+// on every reconcile while the action is Running, so implementations must be
+// idempotent: they observe the state of whatever they started and report it
+// rather than starting it again. Status.StartedAt is set before the first
+// call. A returned error is treated as transient and retried with backoff.
+type IntentHandler interface {
+	Handle(ctx context.Context, cl cluster.Cluster, cowboy *wildwestv1alpha1.Cowboy) (IntentResult, error)
+}
+
+// IntentHandlerFunc adapts a function to the IntentHandler interface.
+type IntentHandlerFunc func(ctx context.Context, cl cluster.Cluster, cowboy *wildwestv1alpha1.Cowboy) (IntentResult, error)
+
+// Handle calls f.
+func (f IntentHandlerFunc) Handle(ctx context.Context, cl cluster.Cluster, cowboy *wildwestv1alpha1.Cowboy) (IntentResult, error) {
+	return f(ctx, cl, cowboy)
+}
+
+// DefaultIntentHandler completes every intent immediately. It is used for
+// intents that no registered handler claims.
+var DefaultIntentHandler IntentHandler = IntentHandlerFunc(func(_ context.Context, _ cluster.Cluster, cowboy *wildwestv1alpha1.Cowboy) (IntentResult, error) {
+	return IntentResult{
+		Outcome: IntentSucceeded,
+		Message: fmt.Sprintf("Yeehaw! %s completed", cowboy.Status.Intent),
+	}, nil
+})
+
+// IntentRegistry maps intent keywords to handlers. The keyword of an
+// intent is its first word, lowercased, so "Ride the range" is handled by
+// the handler registered for "ride".
+type IntentRegistry struct {
+	handlers map[string]IntentHandler
+	fallback IntentHandler
+}
+
+// NewIntentRegistry returns a registry that routes every intent to
+// DefaultIntentHandler until handlers are registered.
+func NewIntentRegistry() *IntentRegistry {
+	return &IntentRegistry{
+		handlers: map[string]IntentHandler{},
+		fallback: DefaultIntentHandler,
+	}
+}
+
+// Register routes intents with the given keyword to h.
+func (r *IntentRegistry) Register(keyword string, h IntentHandler) error {
+	keyword = strings.ToLower(keyword)
+	// Any whitespace, not just spaces, ends the keyword of an intent.
+	if fields := strings.Fields(keyword); len(fields) != 1 || fields[0] != keyword {
+		return fmt.Errorf("intent keyword %q must be a single word", keyword)
+	}
+	if _, exists := r.handlers[keyword]; exists {
+		return fmt.Errorf("intent keyword %q is already registered", keyword)
+	}
+	r.handlers[keyword] = h
+	return nil
+}
+
+// Lookup returns the handler for the given intent.
+func (r *IntentRegistry) Lookup(intent string) IntentHandler {
+	if h, ok := r.handlers[IntentKeyword(intent)]; ok {
+		return h
+	}
+	return r.fallback
+}
+
+// IntentKeyword returns the keyword an intent is registered under.
+func IntentKeyword(intent string) string {
+	fields := strings.Fields(intent)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(fields[0])
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import "testing"
+
+func TestIntentRegistryRegister(t *testing.T) {
+	tests := []struct {
+		keyword string
+		wantErr bool
+	}{
+		{keyword: "ride"},
+		{keyword: "Draw"},
+		{keyword: "", wantErr: true},
+		{keyword: "ride out", wantErr: true},
+		{keyword: " ride", wantErr: true},
+		{keyword: "ride\r", wantErr: true},
+		{keyword: "ride\vout", wantErr: true},
+		{keyword: "ride\u00a0out", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			err := NewIntentRegistry().Register(tt.keyword, DefaultIntentHandler)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Register(%q) error = %v, want error %v", tt.keyword, err, tt.wantErr)
+			}
+		})
+	}
+
+	r := NewIntentRegistry()
+	if err := r.Register("ride", DefaultIntentHandler); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register("RIDE", DefaultIntentHandler); err == nil {
+		t.Error("registering a keyword twice succeeded")
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// inventoryResync is how often a cluster's summary is rebuilt without a
+// Cowboy event, as a safety net for missed events.
+const inventoryResync = 5 * time.Minute
+
+// InventoryReconciler maintains one CowboyInventory per consumer workspace
+// in the provider workspace, named after the workspace's logical cluster. It
+// watches Cowboys in every cluster the apiexport provider engages and
+// rebuilds that cluster's summary whenever a change it depends on happens, so
+// a reconcile request names a cluster and its inventory rather than a Cowboy.
+// Engaging and disengaging a cluster enqueues it too, so workspaces without
+// Cowboys are listed and removed workspaces are dropped right away. Every
+// workspace only writes its own inventory, so workspaces never contend for
+// one object.
+type InventoryReconciler struct {
+	Manager mcmanager.Manager
+
+	clusters *engagedClusters
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *InventoryReconciler) SetupWithManager(mgr mcmanager.Manager) error {
+	r.Manager = mgr
+	r.clusters = newEngagedClusters()
+	if err := mgr.Add(r.clusters); err != nil {
+		return fmt.Errorf("failed to add cluster tracker: %w", err)
+	}
+
+	return mcbuilder.ControllerManagedBy(mgr).
+		Named("cowboy-inventory-controller").
+		Watches(&wildwestv1alpha1.Cowboy{}, inventoryForCluster, mcbuilder.WithPredicates(inventoryChanged)).
+		WatchesRawSource(r.clusters.Source(handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, clusterName string) []mcreconcile.Request {
+			return inventoryRequests(clusterName)
+		}))).
+		Complete(mcreconcile.Func(r.Reconcile))
+}
+
+// inventoryChanged passes the Cowboy events that can change a summary:
+// creates, deletes, and updates to the phase, the Ready condition or whether
+// the Cowboy is being deleted. Spec edits and the rest of the status are not
+// summarized.
+var inventoryChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldCowboy, ok := e.ObjectOld.(*wildwestv1alpha1.Cowboy)
+		if !ok {
+			return true
+		}
+		newCowboy, ok := e.ObjectNew.(*wildwestv1alpha1.Cowboy)
+		if !ok {
+			return true
+		}
+		return oldCowboy.Status.Phase != newCowboy.Status.Phase ||
+			oldCowboy.DeletionTimestamp.IsZero() != newCowboy.DeletionTimestamp.IsZero() ||
+			!equality.Semantic.DeepEqual(
+				meta.FindStatusCondition(oldCowboy.Status.Conditions, wildwestv1alpha1.CowboyConditionReady),
+				meta.FindStatusCondition(newCowboy.Status.Conditions, wildwestv1alpha1.CowboyConditionReady))
+	},
+}
+
+// inventoryForCluster maps an event on any Cowboy to a single request for
+// the cluster it lives in.
+func inventoryForCluster(clusterName string, _ cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, _ client.Object) []mcreconcile.Request {
+		return inventoryRequests(clusterName)
+	})
+}
+
+// inventoryRequests returns the request that rebuilds the summary of a
+// cluster. It names the cluster's CowboyInventory, which has the cluster's
+// name; logical cluster names are valid object names.
+func inventoryRequests(clusterName string) []mcreconcile.Request {
+	return []mcreconcile.Request{{
+		ClusterName: clusterName,
+		Request:     reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterName}},
+	}}
+}
+
+// Reconcile rebuilds the summary of one consumer cluster and stores it in
+// the cluster's CowboyInventory.
+func (r *InventoryReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
+
+	cl, err := r.Manager.GetCluster(ctx, req.ClusterName)
+	if errors.Is(err, multicluster.ErrClusterNotFound) {
+		log.Info("Deleting Cowboy inventory of disengaged cluster")
+		return reconcile.Result{}, r.deleteInventory(ctx, req.Name)
+	}
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	if !r.clusters.Has(req.ClusterName) {
+		// Disengaged, but the provider has not finished removing it.
+		return reconcile.Result{RequeueAfter: clusterRemovalRequeue}, nil
+	}
+
+	cowboys := &wildwestv1alpha1.CowboyList{}
+	if err := cl.GetClient().List(ctx, cowboys); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to list cowboys: %w", err)
+	}
+	summary := summarizeCowboys(req.ClusterName, cowboys.Items)
+
+	if err := r.updateInventory(ctx, req.Name, summary); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: inventoryResync}, nil
+}
+
+// summarizeCowboys aggregates the Cowboys of one cluster. Cowboys that are
+// being deleted are left out.
+func summarizeCowboys(clusterName string, cowboys []wildwestv1alpha1.Cowboy) wildwestv1alpha1.CowboyInventoryStatus {
+	summary := wildwestv1alpha1.CowboyInventoryStatus{Cluster: clusterName}
+	for i := range cowboys {
+		cowboy := &cowboys[i]
+		if !cowboy.DeletionTimestamp.IsZero() {
+			continue
+		}
+		summary.Cowboys++
+		switch cowboy.Status.Phase {
+		case "":
+			summary.Phases.Idle++
+		case wildwestv1alpha1.CowboyPhasePending:
+			summary.Phases.Pending++
+		case wildwestv1alpha1.CowboyPhaseRunning:
+			summary.Phases.Running++
+		case wildwestv1alpha1.CowboyPhaseSucceeded:
+			summary.Phases.Succeeded++
+		case wildwestv1alpha1.CowboyPhaseFailed:
+			summary.Phases.Failed++
+		}
+
+		ready := meta.FindStatusCondition(cowboy.Status.Conditions, wildwestv1alpha1.CowboyConditionReady)
+		if ready == nil || ready.Status != metav1.ConditionFalse {
+			continue
+		}
+		summary.NotReady++
+		if ready.Reason == reasonPending || ready.Reason == reasonInProgress || ready.Reason == reasonWaitingForDependency {
+			continue
+		}
+		summary.Errors = append(summary.Errors, wildwestv1alpha1.CowboyError{
+			Name:    cowboy.Name,
+			Reason:  ready.Reason,
+			Message: ready.Message,
+			Since:   ready.LastTransitionTime,
+		})
+	}
+
+	sort.Slice(summary.Errors, func(i, j int) bool {
+		a, b := &summary.Errors[i], &summary.Errors[j]
+		if !a.Since.Equal(&b.Since) {
+			return b.Since.Before(&a.Since)
+		}
+		return a.Name < b.Name
+	})
+	if len(summary.Errors) > wildwestv1alpha1.CowboyInventoryErrorLimit {
+		summary.Errors = summary.Errors[:wildwestv1alpha1.CowboyInventoryErrorLimit]
+	}
+	return summary
+}
+
+// updateInventory stores a cluster's summary in the CowboyInventory of the
+// given name, creating it on first use. LastUpdated only moves when the
+// summary changed, so rebuilding an unchanged cluster does not write the
+// inventory. The inventory is read from the local manager's cache; a
+// conflict caused by a stale read fails the reconcile, which is retried.
+func (r *InventoryReconciler) updateInventory(ctx context.Context, name string, summary wildwestv1alpha1.CowboyInventoryStatus) error {
+	c := r.Manager.GetLocalManager().GetClient()
+
+	inventory := &wildwestv1alpha1.CowboyInventory{}
+	err := c.Get(ctx, types.NamespacedName{Name: name}, inventory)
+	switch {
+	case apierrors.IsNotFound(err):
+		inventory.Name = name
+		if err := c.Create(ctx, inventory); err != nil {
+			return fmt.Errorf("failed to create cowboy inventory: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get cowboy inventory: %w", err)
+	}
+
+	summary.LastUpdated = inventory.Status.LastUpdated
+	if equality.Semantic.DeepEqual(inventory.Status, summary) {
+		return nil
+	}
+	summary.LastUpdated = metav1.Now()
+	inventory.Status = summary
+	if err := c.Status().Update(ctx, inventory); err != nil {
+		return fmt.Errorf("failed to update cowboy inventory status: %w", err)
+	}
+	return nil
+}
+
+// deleteInventory deletes the CowboyInventory of a disengaged cluster.
+func (r *InventoryReconciler) deleteInventory(ctx context.Context, name string) error {
+	inventory := &wildwestv1alpha1.CowboyInventory{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := r.Manager.GetLocalManager().GetClient().Delete(ctx, inventory); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete cowboy inventory: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// Condition reasons reported on Loadout status.
+const (
+	reasonIssued        = "Issued"
+	reasonWithinQuota   = "WithinQuota"
+	reasonQuotaExceeded = "QuotaExceeded"
+)
+
+// loadoutArmamentIndex indexes Loadouts by the names of the Armaments they
+// request, so catalog changes can be mapped back to Loadouts.
+const loadoutArmamentIndex = "spec.items.armamentRef.name"
+
+// indexLoadoutArmaments is the field indexer for loadoutArmamentIndex.
+func indexLoadoutArmaments(obj client.Object) []string {
+	loadout, ok := obj.(*wildwestv1alpha1.Loadout)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(loadout.Spec.Items))
+	for _, item := range loadout.Spec.Items {
+		names = append(names, item.ArmamentRef.Name)
+	}
+	return names
+}
+
+// LoadoutReconciler reconciles Loadout objects. A Loadout is writable
+// consumer state that is checked against the read-only armament catalog the
+// provider replicates into every consumer workspace, and against the
+// piece limit of the workspace's WildWestQuota.
+type LoadoutReconciler struct {
+	Manager mcmanager.Manager
+
+	clusters *engagedClusters
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LoadoutReconciler) SetupWithManager(mgr mcmanager.Manager) error {
+	r.Manager = mgr
+
+	// The engaged clusters are tracked to find the workspaces a default
+	// WildWestQuota applies to.
+	r.clusters = newEngagedClusters()
+	if err := mgr.Add(r.clusters); err != nil {
+		return fmt.Errorf("failed to add cluster tracker: %w", err)
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &wildwestv1alpha1.Loadout{}, loadoutArmamentIndex, indexLoadoutArmaments); err != nil {
+		return fmt.Errorf("failed to index loadouts by armament: %w", err)
+	}
+
+	return mcbuilder.ControllerManagedBy(mgr).
+		Named("loadout-controller").
+		For(&wildwestv1alpha1.Loadout{}).
+		// Status writes do not change what a Loadout requests, so only
+		// creates, deletes and spec changes re-check the others.
+		Watches(&wildwestv1alpha1.Loadout{}, loadoutsInCluster, mcbuilder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&wildwestv1alpha1.Armament{}, loadoutsForArmament).
+		WatchesRawSource(source.TypedKind(mgr.GetLocalManager().GetCache(), &wildwestv1alpha1.WildWestQuota{},
+			handler.TypedEnqueueRequestsFromMapFunc(r.loadoutsForQuota),
+			predicate.TypedGenerationChangedPredicate[*wildwestv1alpha1.WildWestQuota]{})).
+		Complete(mcreconcile.Func(r.Reconcile))
+}
+
+// loadoutsInCluster maps a Loadout being created, resized or deleted to
+// every Loadout in the same cluster, since it changes the quota left for the
+// others.
+func loadoutsInCluster(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []mcreconcile.Request {
+		loadouts := &wildwestv1alpha1.LoadoutList{}
+		if err := cl.GetClient().List(ctx, loadouts); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list loadouts", "cluster", clusterName)
+			return nil
+		}
+		return loadoutRequests(clusterName, loadouts.Items)
+	})
+}
+
+// loadoutsForArmament maps events on a catalog Armament in the given
+// cluster to the Loadouts in that cluster that request it.
+func loadoutsForArmament(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, armament client.Object) []mcreconcile.Request {
+		loadouts := &wildwestv1alpha1.LoadoutList{}
+		if err := cl.GetClient().List(ctx, loadouts, client.MatchingFields{loadoutArmamentIndex: armament.GetName()}); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list loadouts requesting armament", "cluster", clusterName, "armament", armament.GetName())
+			return nil
+		}
+		return loadoutRequests(clusterName, loadouts.Items)
+	})
+}
+
+// loadoutsForQuota maps a change to a WildWestQuota in the provider
+// workspace to the Loadouts of every engaged workspace it applies or applied
+// to.
+func (r *LoadoutReconciler) loadoutsForQuota(ctx context.Context, quota *wildwestv1alpha1.WildWestQuota) []mcreconcile.Request {
+	var reqs []mcreconcile.Request
+	for _, clusterName := range clustersForQuota(quota, r.clusters) {
+		cl, err := r.Manager.GetCluster(ctx, clusterName)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "failed to get cluster for wildwest quota", "cluster", clusterName, "quota", quota.Name)
+			continue
+		}
+		loadouts := &wildwestv1alpha1.LoadoutList{}
+		if err := cl.GetClient().List(ctx, loadouts); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list loadouts for wildwest quota", "cluster", clusterName, "quota", quota.Name)
+			continue
+		}
+		reqs = append(reqs, loadoutRequests(clusterName, loadouts.Items)...)
+	}
+	return reqs
+}
+
+// loadoutRequests builds reconcile requests for the given Loadouts.
+func loadoutRequests(clusterName string, loadouts []wildwestv1alpha1.Loadout) []mcreconcile.Request {
+	reqs := make([]mcreconcile.Request, 0, len(loadouts))
+	for _, loadout := range loadouts {
+		reqs = append(reqs, mcreconcile.Request{
+			ClusterName: clusterName,
+			Request:     reconcile.Request{NamespacedName: types.NamespacedName{Name: loadout.Name}},
+		})
+	}
+	return reqs
+}
+
+// Reconcile handles reconciliation of Loadout resources across clusters.
+func (r *LoadoutReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
+
+	cl, err := r.Manager.GetCluster(ctx, req.ClusterName)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	client := cl.GetClient()
+
+	loadout := &wildwestv1alpha1.Loadout{}
+	if err := client.Get(ctx, req.NamespacedName, loadout); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to get loadout: %w", err)
+	}
+	if !loadout.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Reconciling Loadout", "name", loadout.Name)
+
+	original := loadout.Status.DeepCopy()
+
+	if err := resolveLoadoutItems(ctx, client, loadout); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.checkQuota(ctx, client, req.ClusterName, loadout); err != nil {
+		return reconcile.Result{}, err
+	}
+	setLoadoutReadyCondition(loadout)
+	loadout.Status.ObservedGeneration = loadout.Generation
+
+	if !equality.Semantic.DeepEqual(original, &loadout.Status) {
+		if err := client.Status().Update(ctx, loadout); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to update loadout status: %w", err)
+		}
+		log.Info("Updated Loadout status", "pieces", loadout.Status.TotalQuantity, "observedGeneration", loadout.Status.ObservedGeneration)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// resolveLoadoutItems looks up every requested Armament in the consumer
+// cluster's view of the catalog and records the outcome per item and as the
+// ArmamentsResolved condition.
+func resolveLoadoutItems(ctx context.Context, c client.Client, loadout *wildwestv1alpha1.Loadout) error {
+	statuses := make([]wildwestv1alpha1.LoadoutItemStatus, 0, len(loadout.Spec.Items))
+	var missing []string
+	var pieces int32
+	var damage int64
+	for _, item := range loadout.Spec.Items {
+		st := wildwestv1alpha1.LoadoutItemStatus{Name: item.ArmamentRef.Name, Quantity: item.Quantity}
+		pieces += item.Quantity
+
+		armament := &wildwestv1alpha1.Armament{}
+		err := c.Get(ctx, types.NamespacedName{Name: item.ArmamentRef.Name}, armament)
+		switch {
+		case err == nil:
+			st.Resolved = true
+			st.Armament = &wildwestv1alpha1.ArmamentSnapshot{
+				Name:        armament.Name,
+				DisplayName: armament.Spec.DisplayName,
+				Kind:        armament.Spec.Kind,
+				Damage:      armament.Spec.Damage,
+				Range:       armament.Spec.Range,
+			}
+			damage += int64(armament.Spec.Damage) * int64(item.Quantity)
+		case apierrors.IsNotFound(err):
+			missing = append(missing, item.ArmamentRef.Name)
+		default:
+			return fmt.Errorf("failed to get armament %s: %w", item.ArmamentRef.Name, err)
+		}
+		statuses = append(statuses, st)
+	}
+	loadout.Status.Items = statuses
+	loadout.Status.TotalQuantity = pieces
+	// Damage ratings are not bounded by the Armament schema, so the sum is
+	// taken in int64 and clamped rather than allowed to wrap around.
+	loadout.Status.TotalDamage = saturateInt32(damage)
+
+	if len(missing) > 0 {
+		setCondition(loadout, wildwestv1alpha1.LoadoutConditionArmamentsResolved, metav1.ConditionFalse, reasonArmamentNotFound,
+			fmt.Sprintf("Armaments not in the catalog: %s", strings.Join(missing, ", ")))
+		return nil
+	}
+	setCondition(loadout, wildwestv1alpha1.LoadoutConditionArmamentsResolved, metav1.ConditionTrue, reasonResolved, "All armaments resolved")
+	return nil
+}
+
+// checkQuota admits the Loadouts of one consumer workspace in creation order
+// until the piece limit of the WildWestQuota that applies to it is used up,
+// and records whether this one made it as the WithinQuota condition. Only
+// admitted Loadouts use up the quota, so an oversized older Loadout does not
+// block newer ones that fit.
+//
+// Quotas live in the provider workspace and are read through the local
+// manager, the same way checkCowboyQuota reads them.
+func (r *LoadoutReconciler) checkQuota(ctx context.Context, c client.Client, clusterName string, loadout *wildwestv1alpha1.Loadout) error {
+	quota, err := quotaForCluster(ctx, r.Manager.GetLocalManager().GetClient(), clusterName)
+	if err != nil {
+		return err
+	}
+	if quota == nil || quota.Spec.MaxLoadoutPieces == nil {
+		setCondition(loadout, wildwestv1alpha1.LoadoutConditionWithinQuota, metav1.ConditionTrue, reasonNoQuota, "No WildWestQuota limits the Loadouts of this workspace")
+		return nil
+	}
+
+	loadouts := &wildwestv1alpha1.LoadoutList{}
+	if err := c.List(ctx, loadouts); err != nil {
+		return fmt.Errorf("failed to list loadouts: %w", err)
+	}
+	items := loadouts.Items
+	sortOldestFirst(items)
+
+	limit := int64(*quota.Spec.MaxLoadoutPieces)
+	var used int64
+	for i := range items {
+		other := &items[i]
+		if !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if other.Name == loadout.Name {
+			break
+		}
+		if pieces := loadoutPieces(other); used+pieces <= limit {
+			used += pieces
+		}
+	}
+
+	if used+loadoutPieces(loadout) > limit {
+		setCondition(loadout, wildwestv1alpha1.LoadoutConditionWithinQuota, metav1.ConditionFalse, reasonQuotaExceeded,
+			fmt.Sprintf("Older admitted loadouts already use %d of the %d pieces allowed by WildWestQuota %s", used, limit, quota.Name))
+		return nil
+	}
+	setCondition(loadout, wildwestv1alpha1.LoadoutConditionWithinQuota, metav1.ConditionTrue, reasonWithinQuota,
+		fmt.Sprintf("Loadout fits in the %d pieces allowed by WildWestQuota %s", limit, quota.Name))
+	return nil
+}
+
+// loadoutPieces returns the number of pieces a Loadout requests.
+func loadoutPieces(loadout *wildwestv1alpha1.Loadout) int64 {
+	var pieces int64
+	for _, item := range loadout.Spec.Items {
+		pieces += int64(item.Quantity)
+	}
+	return pieces
+}
+
+// setLoadoutReadyCondition summarizes the dependent conditions into Ready.
+func setLoadoutReadyCondition(loadout *wildwestv1alpha1.Loadout) {
+	for _, t := range []string{
+		wildwestv1alpha1.LoadoutConditionArmamentsResolved,
+		wildwestv1alpha1.LoadoutConditionWithinQuota,
+	} {
+		c := meta.FindStatusCondition(loadout.Status.Conditions, t)
+		if c != nil && c.Status == metav1.ConditionFalse {
+			setCondition(loadout, wildwestv1alpha1.LoadoutConditionReady, metav1.ConditionFalse, c.Reason, c.Message)
+			return
+		}
+	}
+	setCondition(loadout, wildwestv1alpha1.LoadoutConditionReady, metav1.ConditionTrue, reasonIssued, "Loadout is ready to be issued")
+}
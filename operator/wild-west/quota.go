@@ -0,0 +1,414 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// reasonNoQuota is reported on WithinQuota when no WildWestQuota applies to
+// the cowboy's workspace.
+const reasonNoQuota = "NoQuota"
+
+// cowboyQuotaRequeue is how often a cowboy that does not fit in its
+// workspace's quota is looked at again, so it starts once older cowboys are
+// deleted or the provider raises the quota.
+const cowboyQuotaRequeue = 30 * time.Second
+
+// clusterRemovalRequeue is how often a disengaged cluster is looked at again
+// until the provider has finished removing it.
+const clusterRemovalRequeue = 5 * time.Second
+
+// checkCowboyQuota admits the Cowboys of one consumer workspace in creation
+// order against the WildWestQuota that applies to it and records whether
+// this cowboy made it as the WithinQuota condition. The workspace's usage is
+// reported by the QuotaUsageReconciler.
+//
+// Quotas live in the provider workspace, which is the cluster the local
+// manager is connected to; the consumer workspaces are only reachable
+// through the multicluster manager. A Cowboy counts towards the damage limit
+// with the armament resolved into its status.
+func (r *CowboyReconciler) checkCowboyQuota(ctx context.Context, cl cluster.Cluster, clusterName string, cowboy *wildwestv1alpha1.Cowboy) error {
+	quota, err := quotaForCluster(ctx, r.Manager.GetLocalManager().GetClient(), clusterName)
+	if err != nil {
+		return err
+	}
+	if quota == nil {
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionWithinQuota, metav1.ConditionTrue, reasonNoQuota, "No WildWestQuota applies to this workspace")
+		return nil
+	}
+
+	cowboys := &wildwestv1alpha1.CowboyList{}
+	if err := cl.GetClient().List(ctx, cowboys); err != nil {
+		return fmt.Errorf("failed to list cowboys: %w", err)
+	}
+	items := cowboys.Items
+	for i := range items {
+		// Count this cowboy with the armament resolved in this reconcile.
+		if items[i].Name == cowboy.Name {
+			items[i] = *cowboy
+		}
+	}
+	admission := admitCowboys(quota.Spec, clusterName, items)
+
+	if admission.admitted[cowboy.Name] {
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionWithinQuota, metav1.ConditionTrue, reasonWithinQuota,
+			fmt.Sprintf("Cowboy fits in WildWestQuota %s", quota.Name))
+	} else {
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionWithinQuota, metav1.ConditionFalse, reasonQuotaExceeded,
+			fmt.Sprintf("Older cowboys already use %d cowboys and %d armament damage of WildWestQuota %s",
+				admission.cowboys, saturateInt32(admission.damage), quota.Name))
+	}
+	return nil
+}
+
+// quotaAdmission is the outcome of admitting the Cowboys of one workspace
+// against a WildWestQuota.
+type quotaAdmission struct {
+	// usage is what the workspace reports in the quota's status.
+	usage wildwestv1alpha1.WildWestQuotaUsage
+	// admitted holds the names of the Cowboys that fit in the quota.
+	admitted map[string]bool
+	// cowboys and damage are what the admitted Cowboys use of the quota.
+	cowboys int32
+	damage  int64
+}
+
+// admitCowboys admits the Cowboys of one workspace in creation order against
+// a quota; deleting Cowboys are not counted. Armament damage is summed in
+// int64 since neither a single rating nor the number of Cowboys is bounded,
+// and saturates at math.MaxInt32 in the reported usage.
+func admitCowboys(spec wildwestv1alpha1.WildWestQuotaSpec, clusterName string, cowboys []wildwestv1alpha1.Cowboy) quotaAdmission {
+	sortOldestFirst(cowboys)
+
+	a := quotaAdmission{
+		usage:    wildwestv1alpha1.WildWestQuotaUsage{Cluster: clusterName},
+		admitted: map[string]bool{},
+	}
+	var totalDamage int64
+	for i := range cowboys {
+		cowboy := &cowboys[i]
+		if !cowboy.DeletionTimestamp.IsZero() {
+			continue
+		}
+		damage := int64(cowboyDamage(cowboy))
+		a.usage.Cowboys++
+		totalDamage += damage
+
+		fits := (spec.MaxCowboys == nil || a.cowboys < *spec.MaxCowboys) &&
+			(spec.MaxArmamentDamage == nil || a.damage+damage <= int64(*spec.MaxArmamentDamage))
+		if fits {
+			a.admitted[cowboy.Name] = true
+			a.cowboys++
+			a.damage += damage
+		} else {
+			a.usage.Rejected++
+		}
+	}
+	a.usage.ArmamentDamage = saturateInt32(totalDamage)
+	return a
+}
+
+// sortOldestFirst orders objects by creation time, oldest first, breaking
+// ties by name. Quotas admit objects in this order, so an object that was
+// admitted stays admitted when a newer one pushes its workspace over a limit.
+func sortOldestFirst[T any, PT interface {
+	*T
+	metav1.Object
+}](items []T) {
+	sort.Slice(items, func(i, j int) bool {
+		a, b := PT(&items[i]), PT(&items[j])
+		ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+		if !ta.Equal(&tb) {
+			return ta.Before(&tb)
+		}
+		return a.GetName() < b.GetName()
+	})
+}
+
+// saturateInt32 converts a sum to int32, clamping it at the type's bounds
+// rather than wrapping around.
+func saturateInt32(v int64) int32 {
+	return int32(max(min(v, math.MaxInt32), math.MinInt32))
+}
+
+// cowboysForQuota maps a change to a WildWestQuota in the provider workspace
+// to the Cowboys of every workspace it applies or applied to: the one named
+// in spec.cluster, every engaged workspace for a default quota, and those it
+// reports usage for. Only engaged workspaces have Cowboys to re-check.
+func (r *CowboyReconciler) cowboysForQuota(ctx context.Context, quota *wildwestv1alpha1.WildWestQuota) []mcreconcile.Request {
+	var reqs []mcreconcile.Request
+	for _, clusterName := range clustersForQuota(quota, r.clusters) {
+		cl, err := r.Manager.GetCluster(ctx, clusterName)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "failed to get cluster for wildwest quota", "cluster", clusterName, "quota", quota.Name)
+			continue
+		}
+		cowboys := &wildwestv1alpha1.CowboyList{}
+		if err := cl.GetClient().List(ctx, cowboys); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list cowboys for wildwest quota", "cluster", clusterName, "quota", quota.Name)
+			continue
+		}
+		reqs = append(reqs, cowboyRequests(clusterName, cowboys.Items)...)
+	}
+	return reqs
+}
+
+// clustersForQuota returns the engaged clusters a WildWestQuota applies or
+// applied to: the one named in spec.cluster, every engaged cluster for a
+// default quota, and those it reports usage for.
+func clustersForQuota(quota *wildwestv1alpha1.WildWestQuota, clusters *engagedClusters) []string {
+	clusterNames := []string{quota.Spec.Cluster}
+	if quota.Spec.Cluster == "" {
+		clusterNames = clusters.List()
+	}
+	for _, usage := range quota.Status.Usage {
+		clusterNames = append(clusterNames, usage.Cluster)
+	}
+	sort.Strings(clusterNames)
+	clusterNames = slices.Compact(clusterNames)
+	return slices.DeleteFunc(clusterNames, func(clusterName string) bool {
+		return !clusters.Has(clusterName)
+	})
+}
+
+// quotaForCluster returns the WildWestQuota for the given consumer cluster,
+// falling back to the default quota with an empty spec.cluster. Among
+// several candidates the one with the lowest name wins. It returns nil when
+// no quota applies.
+func quotaForCluster(ctx context.Context, c client.Client, clusterName string) (*wildwestv1alpha1.WildWestQuota, error) {
+	quotas := &wildwestv1alpha1.WildWestQuotaList{}
+	if err := c.List(ctx, quotas); err != nil {
+		return nil, fmt.Errorf("failed to list wildwest quotas: %w", err)
+	}
+	items := quotas.Items
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	var fallback *wildwestv1alpha1.WildWestQuota
+	for i := range items {
+		switch items[i].Spec.Cluster {
+		case clusterName:
+			return &items[i], nil
+		case "":
+			if fallback == nil {
+				fallback = &items[i]
+			}
+		}
+	}
+	return fallback, nil
+}
+
+// QuotaUsageReconciler reports the usage of every consumer workspace in the
+// status of the WildWestQuota that applies to it. A reconcile request only
+// carries the name of a cluster: it is enqueued when a Cowboy in the cluster
+// is created or deleted, starts being deleted or changes its armament, when
+// the cluster is engaged or disengaged, and when a quota that applies or
+// applied to it changes.
+type QuotaUsageReconciler struct {
+	Manager mcmanager.Manager
+
+	clusters *engagedClusters
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QuotaUsageReconciler) SetupWithManager(mgr mcmanager.Manager) error {
+	r.Manager = mgr
+	r.clusters = newEngagedClusters()
+	if err := mgr.Add(r.clusters); err != nil {
+		return fmt.Errorf("failed to add cluster tracker: %w", err)
+	}
+
+	return mcbuilder.ControllerManagedBy(mgr).
+		Named("wildwest-quota-usage-controller").
+		Watches(&wildwestv1alpha1.Cowboy{}, quotaUsageForCluster, mcbuilder.WithPredicates(quotaUsageChanged)).
+		WatchesRawSource(source.TypedKind(mgr.GetLocalManager().GetCache(), &wildwestv1alpha1.WildWestQuota{},
+			handler.TypedEnqueueRequestsFromMapFunc(r.quotaUsageForQuota),
+			predicate.TypedGenerationChangedPredicate[*wildwestv1alpha1.WildWestQuota]{})).
+		WatchesRawSource(r.clusters.Source(handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, clusterName string) []mcreconcile.Request {
+			return quotaUsageRequests(clusterName)
+		}))).
+		Complete(mcreconcile.Func(r.Reconcile))
+}
+
+// quotaUsageChanged passes the Cowboy events that can change a workspace's
+// usage: creates, deletes, and updates that start a deletion or change the
+// armament damage resolved into the status.
+var quotaUsageChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldCowboy, ok := e.ObjectOld.(*wildwestv1alpha1.Cowboy)
+		if !ok {
+			return true
+		}
+		newCowboy, ok := e.ObjectNew.(*wildwestv1alpha1.Cowboy)
+		if !ok {
+			return true
+		}
+		return oldCowboy.DeletionTimestamp.IsZero() != newCowboy.DeletionTimestamp.IsZero() ||
+			cowboyDamage(oldCowboy) != cowboyDamage(newCowboy)
+	},
+}
+
+// quotaUsageForCluster maps an event on any Cowboy to a single request for
+// the cluster it lives in.
+func quotaUsageForCluster(clusterName string, _ cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, _ client.Object) []mcreconcile.Request {
+		return quotaUsageRequests(clusterName)
+	})
+}
+
+// quotaUsageForQuota maps a change to a WildWestQuota to the engaged
+// clusters it applies or applied to.
+func (r *QuotaUsageReconciler) quotaUsageForQuota(_ context.Context, quota *wildwestv1alpha1.WildWestQuota) []mcreconcile.Request {
+	var reqs []mcreconcile.Request
+	for _, clusterName := range clustersForQuota(quota, r.clusters) {
+		reqs = append(reqs, quotaUsageRequests(clusterName)...)
+	}
+	return reqs
+}
+
+// quotaUsageRequests returns the request that recomputes the usage of a
+// cluster.
+func quotaUsageRequests(clusterName string) []mcreconcile.Request {
+	return []mcreconcile.Request{{ClusterName: clusterName}}
+}
+
+// Reconcile recomputes the usage of one consumer cluster. A disengaged
+// cluster is removed from the usage of every quota. The provider may still
+// be tearing the cluster down when it is reported, and it is never torn down
+// when the controller shuts down, so a cluster the manager still knows is
+// looked at again later.
+func (r *QuotaUsageReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
+	local := r.Manager.GetLocalManager().GetClient()
+
+	cl, err := r.Manager.GetCluster(ctx, req.ClusterName)
+	if errors.Is(err, multicluster.ErrClusterNotFound) {
+		log.Info("Removing disengaged cluster from WildWestQuota usage")
+		return ctrl.Result{}, recordQuotaUsage(ctx, local, req.ClusterName, "", nil)
+	}
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	if !r.clusters.Has(req.ClusterName) {
+		// Disengaged, but the provider has not finished removing it.
+		return ctrl.Result{RequeueAfter: clusterRemovalRequeue}, nil
+	}
+
+	quota, err := quotaForCluster(ctx, local, req.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if quota == nil {
+		// A quota that no longer applies may still list the workspace.
+		return ctrl.Result{}, recordQuotaUsage(ctx, local, req.ClusterName, "", nil)
+	}
+
+	cowboys := &wildwestv1alpha1.CowboyList{}
+	if err := cl.GetClient().List(ctx, cowboys); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list cowboys: %w", err)
+	}
+	admission := admitCowboys(quota.Spec, req.ClusterName, cowboys.Items)
+	return ctrl.Result{}, recordQuotaUsage(ctx, local, req.ClusterName, quota.Name, &admission.usage)
+}
+
+// recordQuotaUsage stores the usage of one workspace in the status of the
+// quota named quotaName and removes the workspace from every other quota,
+// which is where it was counted before it moved to a dedicated quota or its
+// quota went away. A nil usage, with an empty quotaName, removes the
+// workspace everywhere.
+//
+// Quotas are read from the cache and only the ones whose usage changes are
+// written. A conflict caused by a stale read fails the reconcile, which is
+// retried.
+func recordQuotaUsage(ctx context.Context, c client.Client, clusterName, quotaName string, usage *wildwestv1alpha1.WildWestQuotaUsage) error {
+	quotas := &wildwestv1alpha1.WildWestQuotaList{}
+	if err := c.List(ctx, quotas); err != nil {
+		return fmt.Errorf("failed to list wildwest quotas: %w", err)
+	}
+
+	for i := range quotas.Items {
+		quota := &quotas.Items[i]
+		var entry *wildwestv1alpha1.WildWestQuotaUsage
+		if quota.Name == quotaName {
+			entry = usage
+		}
+		if !setQuotaUsage(&quota.Status, clusterName, entry) {
+			continue
+		}
+		if err := c.Status().Update(ctx, quota); err != nil {
+			return fmt.Errorf("failed to record usage in wildwest quota %s: %w", quota.Name, err)
+		}
+	}
+	return nil
+}
+
+// setQuotaUsage replaces the entry of one workspace in the quota's usage,
+// or removes it when usage is nil. It reports whether the usage changed.
+func setQuotaUsage(status *wildwestv1alpha1.WildWestQuotaStatus, clusterName string, usage *wildwestv1alpha1.WildWestQuotaUsage) bool {
+	entries := make([]wildwestv1alpha1.WildWestQuotaUsage, 0, len(status.Usage)+1)
+	for _, e := range status.Usage {
+		if e.Cluster != clusterName {
+			entries = append(entries, e)
+		}
+	}
+	if usage != nil {
+		entries = append(entries, *usage)
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Cluster < entries[j].Cluster
+		})
+	}
+	if equality.Semantic.DeepEqual(entries, status.Usage) {
+		return false
+	}
+	status.Usage = entries
+	return true
+}
+
+// cowboyDamage returns the damage rating a Cowboy counts towards its
+// workspace's quota.
+func cowboyDamage(cowboy *wildwestv1alpha1.Cowboy) int32 {
+	if cowboy.Status.Armament == nil {
+		return 0
+	}
+	return cowboy.Status.Armament.Damage
+}
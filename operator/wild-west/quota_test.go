@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+func TestSetQuotaUsage(t *testing.T) {
+	a := wildwestv1alpha1.WildWestQuotaUsage{Cluster: "a", Cowboys: 1}
+	b := wildwestv1alpha1.WildWestQuotaUsage{Cluster: "b", Cowboys: 2}
+	b2 := wildwestv1alpha1.WildWestQuotaUsage{Cluster: "b", Cowboys: 3}
+	c := wildwestv1alpha1.WildWestQuotaUsage{Cluster: "c", Cowboys: 4}
+
+	tests := []struct {
+		name        string
+		usage       []wildwestv1alpha1.WildWestQuotaUsage
+		cluster     string
+		entry       *wildwestv1alpha1.WildWestQuotaUsage
+		want        []wildwestv1alpha1.WildWestQuotaUsage
+		wantChanged bool
+	}{
+		{
+			name:        "adds a new workspace in order",
+			usage:       []wildwestv1alpha1.WildWestQuotaUsage{a, c},
+			cluster:     "b",
+			entry:       &b,
+			want:        []wildwestv1alpha1.WildWestQuotaUsage{a, b, c},
+			wantChanged: true,
+		},
+		{
+			name:        "replaces a changed entry",
+			usage:       []wildwestv1alpha1.WildWestQuotaUsage{a, b, c},
+			cluster:     "b",
+			entry:       &b2,
+			want:        []wildwestv1alpha1.WildWestQuotaUsage{a, b2, c},
+			wantChanged: true,
+		},
+		{
+			name:    "keeps an unchanged entry",
+			usage:   []wildwestv1alpha1.WildWestQuotaUsage{a, b, c},
+			cluster: "b",
+			entry:   &b,
+			want:    []wildwestv1alpha1.WildWestQuotaUsage{a, b, c},
+		},
+		{
+			name:        "removes a workspace",
+			usage:       []wildwestv1alpha1.WildWestQuotaUsage{a, b, c},
+			cluster:     "b",
+			want:        []wildwestv1alpha1.WildWestQuotaUsage{a, c},
+			wantChanged: true,
+		},
+		{
+			name:    "ignores removing an unknown workspace",
+			usage:   []wildwestv1alpha1.WildWestQuotaUsage{a, c},
+			cluster: "b",
+			want:    []wildwestv1alpha1.WildWestQuotaUsage{a, c},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &wildwestv1alpha1.WildWestQuotaStatus{Usage: tt.usage}
+			changed := setQuotaUsage(status, tt.cluster, tt.entry)
+			if changed != tt.wantChanged {
+				t.Errorf("changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !reflect.DeepEqual(status.Usage, tt.want) {
+				t.Errorf("usage = %+v, want %+v", status.Usage, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdmitCowboys(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	cowboy := func(name string, age int, damage int32) wildwestv1alpha1.Cowboy {
+		return wildwestv1alpha1.Cowboy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(time.Unix(1000, 0).Add(-time.Duration(age) * time.Minute)),
+			},
+			Status: wildwestv1alpha1.CowboyStatus{
+				Armament: &wildwestv1alpha1.ArmamentSnapshot{Damage: damage},
+			},
+		}
+	}
+	deleting := cowboy("deleting", 9, 100)
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Unix(1000, 0)}
+
+	tests := []struct {
+		name         string
+		spec         wildwestv1alpha1.WildWestQuotaSpec
+		cowboys      []wildwestv1alpha1.Cowboy
+		wantAdmitted map[string]bool
+		wantUsage    wildwestv1alpha1.WildWestQuotaUsage
+		wantDamage   int64
+	}{
+		{
+			name:         "admits oldest first",
+			spec:         wildwestv1alpha1.WildWestQuotaSpec{MaxCowboys: int32Ptr(2)},
+			cowboys:      []wildwestv1alpha1.Cowboy{cowboy("young", 1, 1), cowboy("old", 3, 1), cowboy("middle", 2, 1)},
+			wantAdmitted: map[string]bool{"old": true, "middle": true},
+			wantUsage:    wildwestv1alpha1.WildWestQuotaUsage{Cluster: "ws", Cowboys: 3, ArmamentDamage: 3, Rejected: 1},
+			wantDamage:   2,
+		},
+		{
+			name:         "skips deleting cowboys",
+			spec:         wildwestv1alpha1.WildWestQuotaSpec{MaxCowboys: int32Ptr(1)},
+			cowboys:      []wildwestv1alpha1.Cowboy{deleting, cowboy("a", 1, 1)},
+			wantAdmitted: map[string]bool{"a": true},
+			wantUsage:    wildwestv1alpha1.WildWestQuotaUsage{Cluster: "ws", Cowboys: 1, ArmamentDamage: 1},
+			wantDamage:   1,
+		},
+		{
+			name: "does not overflow on large damage",
+			spec: wildwestv1alpha1.WildWestQuotaSpec{MaxArmamentDamage: int32Ptr(math.MaxInt32)},
+			cowboys: []wildwestv1alpha1.Cowboy{
+				cowboy("a", 3, math.MaxInt32),
+				cowboy("b", 2, math.MaxInt32),
+				cowboy("c", 1, math.MaxInt32),
+			},
+			wantAdmitted: map[string]bool{"a": true},
+			wantUsage:    wildwestv1alpha1.WildWestQuotaUsage{Cluster: "ws", Cowboys: 3, ArmamentDamage: math.MaxInt32, Rejected: 2},
+			wantDamage:   math.MaxInt32,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := admitCowboys(tt.spec, "ws", tt.cowboys)
+			if !reflect.DeepEqual(got.admitted, tt.wantAdmitted) {
+				t.Errorf("admitted = %v, want %v", got.admitted, tt.wantAdmitted)
+			}
+			if got.usage != tt.wantUsage {
+				t.Errorf("usage = %+v, want %+v", got.usage, tt.wantUsage)
+			}
+			if got.damage != tt.wantDamage {
+				t.Errorf("damage = %d, want %d", got.damage, tt.wantDamage)
+			}
+		})
+	}
+}
+
+func TestRecordQuotaUsage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := wildwestv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	quota := func(name string, usage ...wildwestv1alpha1.WildWestQuotaUsage) *wildwestv1alpha1.WildWestQuota {
+		return &wildwestv1alpha1.WildWestQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     wildwestv1alpha1.WildWestQuotaStatus{Usage: usage},
+		}
+	}
+	ws := wildwestv1alpha1.WildWestQuotaUsage{Cluster: "ws", Cowboys: 2}
+	other := wildwestv1alpha1.WildWestQuotaUsage{Cluster: "other", Cowboys: 1}
+
+	var updated []string
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&wildwestv1alpha1.WildWestQuota{}).
+		WithObjects(quota("default", other, ws), quota("dedicated"), quota("unrelated", other)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				updated = append(updated, obj.GetName())
+				return c.SubResource(subResource).Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	ctx := context.Background()
+
+	record := func(step, quotaName string, usage *wildwestv1alpha1.WildWestQuotaUsage, wantUpdated ...string) {
+		t.Helper()
+		updated = nil
+		if err := recordQuotaUsage(ctx, c, "ws", quotaName, usage); err != nil {
+			t.Fatalf("%s: recordQuotaUsage: %v", step, err)
+		}
+		sort.Strings(updated)
+		if !reflect.DeepEqual(updated, wantUpdated) {
+			t.Errorf("%s: updated quotas = %v, want %v", step, updated, wantUpdated)
+		}
+	}
+
+	// Unchanged usage writes nothing.
+	record("unchanged", "default", &ws)
+	// Moving to a dedicated quota writes the old and the new one only.
+	record("moved", "dedicated", &ws, "dedicated", "default")
+	record("moved again", "dedicated", &ws)
+	// Disengaging removes the workspace everywhere it is listed.
+	record("removed", "", nil, "dedicated")
+	record("removed again", "", nil)
+
+	got := &wildwestv1alpha1.WildWestQuota{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "unrelated"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if want := []wildwestv1alpha1.WildWestQuotaUsage{other}; !reflect.DeepEqual(got.Status.Usage, want) {
+		t.Errorf("unrelated usage = %+v, want %+v", got.Status.Usage, want)
+	}
+}
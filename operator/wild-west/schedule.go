@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// scheduleParser parses spec.schedule. It only accepts the five standard
+// fields: descriptors such as "@every 1s" would let a cowboy run far more
+// often than once a minute.
+var scheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// maxMissedRuns bounds how many missed runs are walked to find the latest
+// one, so a frequent schedule after a long outage cannot stall a reconcile.
+const maxMissedRuns = 100
+
+// parseSchedule parses a spec.schedule the way the CRD pattern and the
+// validating webhook accept it.
+func parseSchedule(spec string) (cron.Schedule, error) {
+	if fields := strings.Fields(spec); len(fields) != 5 {
+		return nil, fmt.Errorf("expected exactly 5 fields, found %d: %s", len(fields), spec)
+	}
+	return scheduleParser.Parse(spec)
+}
+
+// runSchedule starts a new run of the cowboy's intent when spec.schedule
+// came due and returns how long until it comes due next. It returns zero for
+// cowboys without a (valid) schedule.
+//
+// Nothing runs in the background: the reconciler requeues the cowboy for
+// NextRunTime, so the schedule survives controller restarts and works the
+// same for every cluster the multicluster manager engages. A run that came
+// due while the controller was down is started late unless it missed
+// spec.startingDeadlineSeconds; of several missed runs only the latest one
+// is considered, and when more than maxMissedRuns were missed none of them
+// runs.
+func runSchedule(recorder record.EventRecorder, cowboy *wildwestv1alpha1.Cowboy, now time.Time) time.Duration {
+	status := &cowboy.Status
+	if cowboy.Spec.Schedule == "" || cowboy.Spec.Intent == "" {
+		status.NextRunTime = nil
+		status.ScheduledFor = ""
+		return 0
+	}
+	schedule, err := parseSchedule(cowboy.Spec.Schedule)
+	if err != nil {
+		status.NextRunTime = nil
+		status.ScheduledFor = ""
+		recorder.Eventf(cowboy, corev1.EventTypeWarning, "InvalidSchedule", "Cannot parse schedule %q: %v", cowboy.Spec.Schedule, err)
+		return 0
+	}
+	if schedule.Next(now).IsZero() {
+		// e.g. "0 0 30 2 *", which never comes due.
+		status.NextRunTime = nil
+		status.ScheduledFor = ""
+		recorder.Eventf(cowboy, corev1.EventTypeWarning, "InvalidSchedule", "Schedule %q never comes due", cowboy.Spec.Schedule)
+		return 0
+	}
+
+	// A schedule that was just set, or changed since NextRunTime was
+	// computed, starts counting from now.
+	if status.NextRunTime == nil || status.ScheduledFor != cowboy.Spec.Schedule {
+		next := metav1.NewTime(schedule.Next(now))
+		status.NextRunTime = &next
+		status.ScheduledFor = cowboy.Spec.Schedule
+		return next.Sub(now)
+	}
+	if status.NextRunTime.After(now) {
+		return status.NextRunTime.Sub(now)
+	}
+
+	due := status.NextRunTime.Time
+	missed := 0
+	for t := schedule.Next(due); !t.After(now); t = schedule.Next(t) {
+		if missed == maxMissedRuns {
+			next := metav1.NewTime(schedule.Next(now))
+			recorder.Eventf(cowboy, corev1.EventTypeWarning, "ScheduledRunsMissed", "Missed more than %d scheduled runs since %s, waiting for the run due at %s",
+				maxMissedRuns, formatRunTime(status.NextRunTime.Time), formatRunTime(next.Time))
+			status.NextRunTime = &next
+			return next.Sub(now)
+		}
+		due = t
+		missed++
+	}
+	last := metav1.NewTime(due)
+	next := metav1.NewTime(schedule.Next(now))
+	status.LastRunTime = &last
+	status.NextRunTime = &next
+
+	if missed > 0 {
+		recorder.Eventf(cowboy, corev1.EventTypeWarning, "ScheduledRunsMissed", "Missed %d scheduled runs before %s", missed, formatRunTime(due))
+	}
+
+	deadline := cowboy.Spec.StartingDeadlineSeconds
+	switch {
+	case deadline != nil && now.Sub(due) > time.Duration(*deadline)*time.Second:
+		recorder.Eventf(cowboy, corev1.EventTypeWarning, "ScheduledRunSkipped", "Skipped the run due at %s, it missed its starting deadline of %ds", formatRunTime(due), *deadline)
+	case status.Phase == wildwestv1alpha1.CowboyPhasePending || status.Phase == wildwestv1alpha1.CowboyPhaseRunning:
+		if cowboy.Spec.ConcurrencyPolicy != wildwestv1alpha1.CowboyConcurrencyReplace {
+			recorder.Eventf(cowboy, corev1.EventTypeNormal, "ScheduledRunSkipped", "Skipped the run due at %s, the previous run is still %s", formatRunTime(due), status.Phase)
+			break
+		}
+		status.Result = fmt.Sprintf("Replaced by the run due at %s", formatRunTime(due))
+		startAction(cowboy)
+		recorder.Eventf(cowboy, corev1.EventTypeNormal, "ScheduledRunStarted", "Replaced the previous run with the run due at %s", formatRunTime(due))
+	default:
+		startAction(cowboy)
+		recorder.Eventf(cowboy, corev1.EventTypeNormal, "ScheduledRunStarted", "Started the run due at %s", formatRunTime(due))
+	}
+
+	return next.Sub(now)
+}
+
+// formatRunTime formats a schedule time for events and results.
+func formatRunTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+func TestRunSchedule(t *testing.T) {
+	const hourly = "0 * * * *"
+	at := func(hhmm string) *metav1.Time {
+		ts, err := time.Parse(time.RFC3339, "2026-10-16T"+hhmm+":00Z")
+		if err != nil {
+			panic(err)
+		}
+		mt := metav1.NewTime(ts)
+		return &mt
+	}
+	deadline := int64(60)
+	lastWeek := metav1.NewTime(at("10:00").AddDate(0, 0, -7))
+
+	tests := []struct {
+		name        string
+		spec        wildwestv1alpha1.CowboySpec
+		status      wildwestv1alpha1.CowboyStatus
+		now         *metav1.Time
+		wantRequeue time.Duration
+		wantLast    *metav1.Time
+		wantNext    *metav1.Time
+		wantPhase   wildwestv1alpha1.CowboyPhase
+	}{
+		{
+			name:        "new schedule starts counting from now",
+			spec:        wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: hourly},
+			now:         at("10:30"),
+			wantRequeue: 30 * time.Minute,
+			wantNext:    at("11:00"),
+		},
+		{
+			name:        "not yet due waits for the next run",
+			spec:        wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: hourly},
+			status:      wildwestv1alpha1.CowboyStatus{NextRunTime: at("11:00"), ScheduledFor: hourly},
+			now:         at("10:45"),
+			wantRequeue: 15 * time.Minute,
+			wantNext:    at("11:00"),
+		},
+		{
+			name:        "due run starts",
+			spec:        wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: hourly},
+			status:      wildwestv1alpha1.CowboyStatus{NextRunTime: at("11:00"), ScheduledFor: hourly},
+			now:         at("11:00"),
+			wantRequeue: time.Hour,
+			wantLast:    at("11:00"),
+			wantNext:    at("12:00"),
+			wantPhase:   wildwestv1alpha1.CowboyPhasePending,
+		},
+		{
+			name:        "missed runs start only the latest",
+			spec:        wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: hourly},
+			status:      wildwestv1alpha1.CowboyStatus{NextRunTime: at("08:00"), ScheduledFor: hourly},
+			now:         at("10:30"),
+			wantRequeue: 30 * time.Minute,
+			wantLast:    at("10:00"),
+			wantNext:    at("11:00"),
+			wantPhase:   wildwestv1alpha1.CowboyPhasePending,
+		},
+		{
+			name:        "too many missed runs wait for the next run",
+			spec:        wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: hourly},
+			status:      wildwestv1alpha1.CowboyStatus{NextRunTime: &lastWeek, ScheduledFor: hourly},
+			now:         at("10:30"),
+			wantRequeue: 30 * time.Minute,
+			wantNext:    at("11:00"),
+		},
+		{
+			name:        "run past its starting deadline is skipped",
+			spec:        wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: hourly, StartingDeadlineSeconds: &deadline},
+			status:      wildwestv1alpha1.CowboyStatus{NextRunTime: at("10:00"), ScheduledFor: hourly},
+			now:         at("10:30"),
+			wantRequeue: 30 * time.Minute,
+			wantLast:    at("10:00"),
+			wantNext:    at("11:00"),
+		},
+		{
+			name:        "schedule changed before its first run is recomputed",
+			spec:        wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: hourly},
+			status:      wildwestv1alpha1.CowboyStatus{NextRunTime: at("23:00"), ScheduledFor: "0 23 * * *"},
+			now:         at("10:30"),
+			wantRequeue: 30 * time.Minute,
+			wantNext:    at("11:00"),
+		},
+		{
+			name: "schedule changed after a run is recomputed",
+			spec: wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: "*/15 * * * *"},
+			status: wildwestv1alpha1.CowboyStatus{
+				LastRunTime: at("10:00"), NextRunTime: at("11:00"), ScheduledFor: hourly,
+			},
+			now:         at("10:20"),
+			wantRequeue: 10 * time.Minute,
+			wantLast:    at("10:00"),
+			wantNext:    at("10:30"),
+		},
+		{
+			name:   "cleared schedule stops",
+			spec:   wildwestv1alpha1.CowboySpec{Intent: "ride"},
+			status: wildwestv1alpha1.CowboyStatus{NextRunTime: at("11:00"), ScheduledFor: hourly},
+			now:    at("10:30"),
+		},
+		{
+			name:   "invalid schedule stops",
+			spec:   wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: "every hour"},
+			status: wildwestv1alpha1.CowboyStatus{NextRunTime: at("11:00"), ScheduledFor: hourly},
+			now:    at("10:30"),
+		},
+		{
+			name:   "descriptor schedule stops",
+			spec:   wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: "@every 1s"},
+			status: wildwestv1alpha1.CowboyStatus{NextRunTime: at("11:00"), ScheduledFor: hourly},
+			now:    at("10:30"),
+		},
+		{
+			name:   "schedule that never comes due stops",
+			spec:   wildwestv1alpha1.CowboySpec{Intent: "ride", Schedule: "0 0 30 2 *"},
+			status: wildwestv1alpha1.CowboyStatus{NextRunTime: at("11:00"), ScheduledFor: hourly},
+			now:    at("10:30"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cowboy := &wildwestv1alpha1.Cowboy{Spec: tt.spec, Status: tt.status}
+			requeue := runSchedule(record.NewFakeRecorder(10), cowboy, tt.now.Time)
+
+			status := cowboy.Status
+			if requeue != tt.wantRequeue {
+				t.Errorf("requeue = %v, want %v", requeue, tt.wantRequeue)
+			}
+			if !status.LastRunTime.Equal(tt.wantLast) {
+				t.Errorf("lastRunTime = %v, want %v", status.LastRunTime, tt.wantLast)
+			}
+			if !status.NextRunTime.Equal(tt.wantNext) {
+				t.Errorf("nextRunTime = %v, want %v", status.NextRunTime, tt.wantNext)
+			}
+			wantScheduledFor := ""
+			if tt.wantNext != nil {
+				wantScheduledFor = tt.spec.Schedule
+			}
+			if status.ScheduledFor != wantScheduledFor {
+				t.Errorf("scheduledFor = %q, want %q", status.ScheduledFor, wantScheduledFor)
+			}
+			if status.Phase != tt.wantPhase {
+				t.Errorf("phase = %q, want %q", status.Phase, tt.wantPhase)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// secretRefsIndex indexes Cowboys by the "<namespace>/<name>" keys of the
+// Secrets they reference, so Secret events can be mapped back to Cowboys.
+const secretRefsIndex = "spec.secretRefs"
+
+// indexSecretRefs is the field indexer for secretRefsIndex.
+func indexSecretRefs(obj client.Object) []string {
+	cowboy, ok := obj.(*wildwestv1alpha1.Cowboy)
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(cowboy.Spec.SecretRefs))
+	for _, ref := range cowboy.Spec.SecretRefs {
+		keys = append(keys, secretKey(ref.Namespace, ref.Name))
+	}
+	return keys
+}
+
+func secretKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// cowboysForSecret maps events on a Secret in the given cluster to the
+// Cowboys in that same cluster that reference it.
+func cowboysForSecret(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, secret client.Object) []mcreconcile.Request {
+		cowboys := &wildwestv1alpha1.CowboyList{}
+		key := secretKey(secret.GetNamespace(), secret.GetName())
+		if err := cl.GetClient().List(ctx, cowboys, client.MatchingFields{secretRefsIndex: key}); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list cowboys referencing secret", "cluster", clusterName, "secret", key)
+			return nil
+		}
+		return cowboyRequests(clusterName, cowboys.Items)
+	})
+}
+
+// cowboyRequests builds reconcile requests for the given Cowboys.
+func cowboyRequests(clusterName string, cowboys []wildwestv1alpha1.Cowboy) []mcreconcile.Request {
+	reqs := make([]mcreconcile.Request, 0, len(cowboys))
+	for _, cowboy := range cowboys {
+		reqs = append(reqs, mcreconcile.Request{
+			ClusterName: clusterName,
+			Request:     reconcile.Request{NamespacedName: types.NamespacedName{Name: cowboy.Name}},
+		})
+	}
+	return reqs
+}
+
+// resolveSecretRefs looks up every Secret referenced by the cowboy in the
+// consumer cluster and records the outcome per reference and as the
+// SecretsResolved condition. Secrets are reachable through the APIExport's
+// permission claim. They are read with an uncached reader: the cache would
+// hold every Secret of every consumer workspace, and a claim that was not
+// accepted only surfaces as Forbidden on a live request. Secret metadata is
+// watched, so unresolved references are re-checked as soon as the Secret
+// shows up or changes.
+//
+// A reference that declares keys only resolves when the Secret contains all
+// of them with values matching their patterns. Offending keys are reported
+// by name; secret values never leave this function.
+func resolveSecretRefs(ctx context.Context, c client.Reader, cowboy *wildwestv1alpha1.Cowboy) error {
+	refs := cowboy.Spec.SecretRefs
+	if len(refs) == 0 {
+		cowboy.Status.SecretRefs = nil
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionSecretsResolved, metav1.ConditionTrue, reasonNoSecretRefs, "Cowboy does not reference any Secrets")
+		return nil
+	}
+
+	statuses := make([]wildwestv1alpha1.SecretReferenceStatus, 0, len(refs))
+	var unresolved []string
+	reason := ""
+	for _, ref := range refs {
+		st := wildwestv1alpha1.SecretReferenceStatus{Name: ref.Name, Namespace: ref.Namespace}
+
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret)
+		switch {
+		case err == nil:
+			checkSecretKeys(secret, ref.Keys, &st)
+		case apierrors.IsNotFound(err):
+			st.Reason = reasonSecretNotFound
+			st.Message = "Secret does not exist"
+		case apierrors.IsForbidden(err):
+			st.Reason = reasonSecretForbidden
+			st.Message = "Secret is not readable; accept the secrets permission claim on the APIBinding"
+		default:
+			return fmt.Errorf("failed to get secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+
+		if !st.Resolved {
+			unresolved = append(unresolved, secretKey(ref.Namespace, ref.Name))
+			// A missing claim blocks every reference, so it wins over the
+			// reason of the first unresolved one.
+			if reason == "" || st.Reason == reasonSecretForbidden {
+				reason = st.Reason
+			}
+		}
+		statuses = append(statuses, st)
+	}
+	cowboy.Status.SecretRefs = statuses
+
+	if len(unresolved) > 0 {
+		setCondition(cowboy, wildwestv1alpha1.CowboyConditionSecretsResolved, metav1.ConditionFalse, reason,
+			fmt.Sprintf("Unresolved secret references: %s", strings.Join(unresolved, ", ")))
+		return nil
+	}
+	setCondition(cowboy, wildwestv1alpha1.CowboyConditionSecretsResolved, metav1.ConditionTrue, reasonResolved, "All secret references resolved")
+	return nil
+}
+
+// checkSecretKeys validates the data of a resolved Secret against the keys
+// declared on its reference and records the outcome in st.
+func checkSecretKeys(secret *corev1.Secret, keys []wildwestv1alpha1.SecretKeyRequirement, st *wildwestv1alpha1.SecretReferenceStatus) {
+	for _, key := range keys {
+		value, ok := secret.Data[key.Name]
+		if !ok || len(value) == 0 {
+			st.MissingKeys = append(st.MissingKeys, key.Name)
+			continue
+		}
+		if key.Pattern == "" {
+			continue
+		}
+		// The webhook rejects patterns that do not compile; without it a
+		// broken pattern marks the key invalid rather than failing the
+		// reconcile.
+		re, err := regexp.Compile(key.Pattern)
+		if err != nil || !re.Match(value) {
+			st.InvalidKeys = append(st.InvalidKeys, key.Name)
+		}
+	}
+
+	switch {
+	case len(st.MissingKeys) > 0:
+		st.Reason = reasonSecretKeysMissing
+		st.Message = fmt.Sprintf("Secret is missing keys: %s", strings.Join(st.MissingKeys, ", "))
+	case len(st.InvalidKeys) > 0:
+		st.Reason = reasonSecretKeysInvalid
+		st.Message = fmt.Sprintf("Secret keys do not match their pattern: %s", strings.Join(st.InvalidKeys, ", "))
+	default:
+		st.Resolved = true
+		st.Reason = reasonResolved
+	}
+}
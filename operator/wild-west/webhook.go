@@ -0,0 +1,250 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wildwest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+
+	wildwestv1alpha1 "github.com/platform-mesh/provider-quickstart/apis/wildwest/v1alpha1"
+)
+
+// CowboyValidatingWebhookPath is the path the Cowboy validating webhook is
+// served on. It must match the URL in config/webhook.
+const CowboyValidatingWebhookPath = "/validate-wildwest-platform-mesh-io-v1alpha1-cowboy"
+
+// CowboyValidator rejects Cowboys whose spec cannot be reconciled.
+//
+// In kcp the ValidatingWebhookConfiguration lives in the provider workspace
+// next to the APIExport and is called for Cowboys in every consumer
+// workspace that binds it. The consumer workspace is taken from the
+// kcp.io/cluster annotation on the admitted object and its armament catalog
+// is read through the manager's cluster for that workspace.
+type CowboyValidator struct {
+	Manager mcmanager.Manager
+
+	decoder admission.Decoder
+}
+
+// SetupWebhookWithManager registers the validator with the manager's
+// webhook server.
+func (v *CowboyValidator) SetupWebhookWithManager(mgr mcmanager.Manager) error {
+	v.Manager = mgr
+	v.decoder = admission.NewDecoder(scheme.Scheme)
+	mgr.GetWebhookServer().Register(CowboyValidatingWebhookPath, &webhook.Admission{Handler: v})
+	return nil
+}
+
+// Handle implements admission.Handler.
+func (v *CowboyValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	cowboy := &wildwestv1alpha1.Cowboy{}
+	if err := v.decoder.Decode(req, cowboy); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode cowboy: %w", err))
+	}
+
+	// Objects on their way out and updates that leave the spec alone (the
+	// controller adding or removing its finalizer) are always allowed, so a
+	// catalog item disappearing can never block deletion.
+	checkCatalog := true
+	if req.Operation == admissionv1.Update {
+		if !cowboy.DeletionTimestamp.IsZero() {
+			return admission.Allowed("")
+		}
+		old := &wildwestv1alpha1.Cowboy{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode old cowboy: %w", err))
+		}
+		if equality.Semantic.DeepEqual(old.Spec, cowboy.Spec) {
+			return admission.Allowed("")
+		}
+		checkCatalog = !equality.Semantic.DeepEqual(old.Spec.ArmamentRef, cowboy.Spec.ArmamentRef)
+	}
+
+	errs := validateCowboySpec(&cowboy.Spec, field.NewPath("spec"))
+	errs = append(errs, validateDependsOn(cowboy.Name, cowboy.Spec.DependsOn, field.NewPath("spec", "dependsOn"))...)
+	if checkCatalog && len(errs) == 0 && cowboy.Spec.ArmamentRef != nil {
+		catalogErr, err := v.validateArmamentRef(ctx, cowboy)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if catalogErr != nil {
+			errs = append(errs, catalogErr)
+		}
+	}
+	if len(errs) == 0 {
+		return admission.Allowed("")
+	}
+
+	invalid := apierrors.NewInvalid(wildwestv1alpha1.GroupVersion.WithKind("Cowboy").GroupKind(), cowboy.Name, errs)
+	return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result:  &invalid.ErrStatus,
+	}}
+}
+
+// validateArmamentRef checks that spec.armamentRef names an Armament in the
+// consumer workspace's catalog.
+func (v *CowboyValidator) validateArmamentRef(ctx context.Context, cowboy *wildwestv1alpha1.Cowboy) (*field.Error, error) {
+	clusterName := logicalcluster.From(cowboy)
+	if clusterName.Empty() {
+		// Outside kcp there is no workspace to look the catalog up in; the
+		// controller reports unknown references as ArmamentNotFound.
+		log.FromContext(ctx).V(1).Info("Skipping armament catalog check for cowboy without logical cluster", "name", cowboy.Name)
+		return nil, nil
+	}
+
+	cl, err := v.Manager.GetCluster(ctx, clusterName.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster %s: %w", clusterName, err)
+	}
+
+	name := cowboy.Spec.ArmamentRef.Name
+	if err := cl.GetClient().Get(ctx, types.NamespacedName{Name: name}, &wildwestv1alpha1.Armament{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.NotFound(field.NewPath("spec", "armamentRef", "name"), name), nil
+		}
+		return nil, fmt.Errorf("failed to get armament %s: %w", name, err)
+	}
+	return nil, nil
+}
+
+// validateCowboySpec checks the parts of a CowboySpec that can be validated
+// without looking at other objects.
+func validateCowboySpec(spec *wildwestv1alpha1.CowboySpec, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	seen := make(map[string]bool, len(spec.SecretRefs))
+	for i, ref := range spec.SecretRefs {
+		refPath := fldPath.Child("secretRefs").Index(i)
+		if ref.Name == "" {
+			errs = append(errs, field.Required(refPath.Child("name"), "secret name must be set"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(ref.Name) {
+				errs = append(errs, field.Invalid(refPath.Child("name"), ref.Name, msg))
+			}
+		}
+		if ref.Namespace == "" {
+			errs = append(errs, field.Required(refPath.Child("namespace"), "secret namespace must be set"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(ref.Namespace) {
+				errs = append(errs, field.Invalid(refPath.Child("namespace"), ref.Namespace, msg))
+			}
+		}
+
+		key := secretKey(ref.Namespace, ref.Name)
+		if seen[key] {
+			errs = append(errs, field.Duplicate(refPath, key))
+		}
+		seen[key] = true
+
+		errs = append(errs, validateSecretKeys(ref.Keys, refPath.Child("keys"))...)
+	}
+
+	if spec.Schedule != "" {
+		if _, err := parseSchedule(spec.Schedule); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("schedule"), spec.Schedule, err.Error()))
+		}
+	}
+
+	if spec.ArmamentRef != nil {
+		namePath := fldPath.Child("armamentRef", "name")
+		if spec.ArmamentRef.Name == "" {
+			errs = append(errs, field.Required(namePath, "armament name must be set"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(spec.ArmamentRef.Name) {
+				errs = append(errs, field.Invalid(namePath, spec.ArmamentRef.Name, msg))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateSecretKeys checks the keys declared on a secret reference. Key
+// names follow the rules for Secret data keys and patterns must compile.
+func validateSecretKeys(keys []wildwestv1alpha1.SecretKeyRequirement, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	seen := make(map[string]bool, len(keys))
+	for i, key := range keys {
+		keyPath := fldPath.Index(i)
+		if key.Name == "" {
+			errs = append(errs, field.Required(keyPath.Child("name"), "key name must be set"))
+		} else {
+			for _, msg := range validation.IsConfigMapKey(key.Name) {
+				errs = append(errs, field.Invalid(keyPath.Child("name"), key.Name, msg))
+			}
+		}
+		if seen[key.Name] {
+			errs = append(errs, field.Duplicate(keyPath.Child("name"), key.Name))
+		}
+		seen[key.Name] = true
+
+		if key.Pattern != "" {
+			if _, err := regexp.Compile(key.Pattern); err != nil {
+				errs = append(errs, field.Invalid(keyPath.Child("pattern"), key.Pattern, err.Error()))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateDependsOn checks spec.dependsOn. Only direct self-references are
+// rejected here; longer cycles are reported by the controller as Blocked
+// because they depend on other objects that may change later.
+func validateDependsOn(name string, deps []wildwestv1alpha1.CowboyReference, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	seen := make(map[string]bool, len(deps))
+	for i, dep := range deps {
+		namePath := fldPath.Index(i).Child("name")
+		if dep.Name == "" {
+			errs = append(errs, field.Required(namePath, "cowboy name must be set"))
+			continue
+		}
+		for _, msg := range validation.IsDNS1123Subdomain(dep.Name) {
+			errs = append(errs, field.Invalid(namePath, dep.Name, msg))
+		}
+		if dep.Name == name {
+			errs = append(errs, field.Invalid(namePath, dep.Name, "a cowboy cannot depend on itself"))
+		}
+		if seen[dep.Name] {
+			errs = append(errs, field.Duplicate(namePath, dep.Name))
+		}
+		seen[dep.Name] = true
+	}
+
+	return errs
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+
+	configwebhook "github.com/platform-mesh/provider-quickstart/config/webhook"
+)
+
+// BootstrapWebhook registers the Cowboy validating webhook in the provider
+// workspace. kcp calls webhooks configured next to an APIExport for the
+// exported resources in every consumer workspace, so this is the only place
+// the webhook has to be registered. url is where the controller's webhook
+// server is reachable from kcp and caBundle is the PEM CA that signed its
+// serving certificate.
+func BootstrapWebhook(ctx context.Context, config *rest.Config, url string, caBundle []byte) error {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	cache := memory.NewMemCacheClient(discoveryClient)
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(cache)
+
+	raw, err := renderWebhookConfiguration(url, caBundle)
+	if err != nil {
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Bootstrapping validating webhook", "url", url)
+
+	var lastErr error
+	err = wait.PollUntilContextCancel(ctx, time.Second, true, func(ctx context.Context) (bool, error) {
+		if err := createResource(ctx, dynamicClient, mapper, raw); err != nil {
+			logger.Info("failed to bootstrap validating webhook, retrying", "error", err)
+			lastErr = err
+			cache.Invalidate()
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("%w: %v", err, lastErr)
+	}
+	return err
+}
+
+// renderWebhookConfiguration fills the client config of the embedded
+// ValidatingWebhookConfiguration.
+func renderWebhookConfiguration(url string, caBundle []byte) ([]byte, error) {
+	cfg := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	if err := yaml.Unmarshal(configwebhook.ValidatingWebhookConfiguration, cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal validating webhook configuration: %w", err)
+	}
+	for i := range cfg.Webhooks {
+		cfg.Webhooks[i].ClientConfig = admissionregistrationv1.WebhookClientConfig{
+			URL:      &url,
+			CABundle: caBundle,
+		}
+	}
+
+	raw, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal validating webhook configuration: %w", err)
+	}
+	return raw, nil
+}
@@ -20,7 +20,11 @@ limitations under the License.
 // seam where a real backend can be substituted.
 package external
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+)
 
 // Armament is the external-source representation of a catalog item. It is
 // intentionally decoupled from the kubernetes API type so that backend
@@ -31,6 +35,28 @@ type Armament struct {
 	Kind        string
 	Damage      int32
 	Range       int32
+
+	// Category-specific attributes. A source sets at most the one matching
+	// Kind; the others are ignored.
+	Firearm *FirearmAttributes
+	Rope    *RopeAttributes
+	Blade   *BladeAttributes
+}
+
+// FirearmAttributes describe revolvers and rifles.
+type FirearmAttributes struct {
+	Caliber  string
+	Capacity int32
+}
+
+// RopeAttributes describe ropes. Length is in meters.
+type RopeAttributes struct {
+	Length int32
+}
+
+// BladeAttributes describe blades. Length is in centimeters.
+type BladeAttributes struct {
+	Length int32
 }
 
 // Client lists the full set of armaments currently available from the
@@ -40,3 +66,105 @@ type Armament struct {
 type Client interface {
 	List(ctx context.Context) ([]Armament, error)
 }
+
+// Watcher is implemented by sources that can tell when their catalog may
+// have changed. The sync controller runs a sync as soon as a value arrives
+// on the returned channel instead of waiting for the next interval; the
+// interval keeps running as a fallback.
+type Watcher interface {
+	// Watch starts watching the catalog until ctx is cancelled. Changes that
+	// arrive while a previous notification is still pending are coalesced
+	// into it.
+	Watch(ctx context.Context) (<-chan struct{}, error)
+}
+
+// Page is one page of a paginated catalog listing.
+type Page struct {
+	Items []Armament
+
+	// Continue is the cursor for the next page. It is empty on the last
+	// page.
+	Continue string
+}
+
+// Pager is implemented by sources that can list their catalog in pages. The
+// sync controller prefers it over List so that its memory use is bounded by
+// the page size rather than by the size of the catalog. Together, the pages
+// of one listing must make up the same complete set List returns.
+type Pager interface {
+	// ListPage returns the page starting at cursor; an empty cursor starts a
+	// new listing. limit is a hint for the page size and zero leaves it to
+	// the source.
+	ListPage(ctx context.Context, cursor string, limit int) (Page, error)
+}
+
+// ForEachPage lists the complete catalog of c and calls fn for every page in
+// order. Sources implementing Pager are listed page by page; others are
+// listed with List and passed to fn as a single page. It stops at the first
+// error returned by the source or by fn.
+func ForEachPage(ctx context.Context, c Client, limit int, fn func(items []Armament) error) error {
+	pager, ok := c.(Pager)
+	if !ok {
+		items, err := c.List(ctx)
+		if err != nil {
+			return err
+		}
+		return fn(items)
+	}
+
+	cursor := ""
+	for {
+		page, err := pager.ListPage(ctx, cursor, limit)
+		if err != nil {
+			return err
+		}
+		if err := fn(page.Items); err != nil {
+			return err
+		}
+		if page.Continue == "" {
+			return nil
+		}
+		if page.Continue == cursor {
+			return fmt.Errorf("source returned cursor %q for the page it starts", cursor)
+		}
+		cursor = page.Continue
+	}
+}
+
+// Change is one entry of a catalog change feed.
+type Change struct {
+	// Armament is the new state of the item. Only ExternalID is set when the
+	// item was deleted.
+	Armament Armament
+
+	// Deleted marks an item that was removed from the catalog.
+	Deleted bool
+}
+
+// Delta is a batch of changes read from a change feed.
+type Delta struct {
+	// Changes are in the order they happened in the external system.
+	Changes []Change
+
+	// Watermark is the opaque position after the last change, to be passed
+	// to the next Changes call.
+	Watermark string
+}
+
+// ErrWatermarkExpired is returned by DeltaClient.Changes when the feed no
+// longer reaches back to the given watermark. The sync controller then falls
+// back to a full sync.
+var ErrWatermarkExpired = errors.New("watermark expired")
+
+// DeltaClient is implemented by sources with a change feed. Between full
+// syncs the sync controller applies only the changes since the watermark it
+// persisted, so a steady-state sync touches only the armaments that changed.
+type DeltaClient interface {
+	Client
+
+	// Changes returns the changes after watermark. An empty watermark
+	// returns no changes and the current position of the feed, which the
+	// controller reads before a full listing so that changes made while it
+	// lists are not lost. Applying a change twice must be harmless.
+	Changes(ctx context.Context, watermark string) (Delta, error)
+}
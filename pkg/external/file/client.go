@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package file is an external.Client that reads the armament catalog from a
+// YAML, JSON or CSV document kept in a local file or in a ConfigMap in the
+// provider workspace. Both sources watch their document, so a catalog kept
+// in git and applied by a GitOps tool is synced as soon as it lands.
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// kubeletDataDir is the symlink kubelet swaps when it updates a mounted
+// ConfigMap or Secret.
+const kubeletDataDir = "..data"
+
+// Client reads the armament catalog from a local file.
+type Client struct {
+	path   string
+	format Format
+}
+
+// New returns a file external.Client. The format is taken from the file's
+// extension.
+func New(path string) (*Client, error) {
+	if path == "" {
+		return nil, fmt.Errorf("catalog file path must be set")
+	}
+	format, err := FormatFromName(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{path: path, format: format}, nil
+}
+
+// List reads and decodes the complete catalog. The file is read on every
+// call, so List always reflects what is on disk.
+func (c *Client) List(_ context.Context) ([]external.Armament, error) {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog file: %w", err)
+	}
+	return Parse(data, c.format)
+}
+
+// Watch implements external.Watcher. It watches the directory holding the
+// file rather than the file itself: editors replace files by renaming and
+// kubelet updates a mounted ConfigMap by swapping a symlink, and a watch on
+// the file would miss both.
+func (c *Client) Watch(ctx context.Context) (<-chan struct{}, error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	if err := w.Add(filepath.Dir(c.path)); err != nil {
+		_ = w.Close()
+		return nil, fmt.Errorf("failed to watch %s: %w", filepath.Dir(c.path), err)
+	}
+
+	logger := log.FromContext(ctx).WithName("catalog-file")
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer w.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				if c.affects(ev) {
+					notify(changes)
+				}
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+				logger.Error(err, "catalog file watch failed", "path", c.path)
+			}
+		}
+	}()
+	return changes, nil
+}
+
+// affects reports whether ev may have changed the catalog file.
+func (c *Client) affects(ev fsnotify.Event) bool {
+	if ev.Op == fsnotify.Chmod {
+		return false
+	}
+	name := filepath.Base(ev.Name)
+	return name == filepath.Base(c.path) || name == kubeletDataDir
+}
+
+// notify queues a change notification unless one is already pending.
+func notify(changes chan<- struct{}) {
+	select {
+	case changes <- struct{}{}:
+	default:
+	}
+}
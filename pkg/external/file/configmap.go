@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// ConfigMapClient reads the armament catalog from a key of a ConfigMap in
+// the provider workspace. The format is taken from the key's extension, so
+// `kubectl create configmap --from-file=catalog.yaml` works as is.
+type ConfigMapClient struct {
+	reader    client.Reader
+	informers cache.Informers
+	name      types.NamespacedName
+	key       string
+	format    Format
+}
+
+// NewConfigMap returns an external.Client that reads key of the named
+// ConfigMap through reader and watches it through informers. Both are
+// usually the manager's cache.
+func NewConfigMap(reader client.Reader, informers cache.Informers, name types.NamespacedName, key string) (*ConfigMapClient, error) {
+	if name.Namespace == "" || name.Name == "" {
+		return nil, fmt.Errorf("catalog ConfigMap namespace and name must be set")
+	}
+	format, err := FormatFromName(key)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfigMapClient{reader: reader, informers: informers, name: name, key: key, format: format}, nil
+}
+
+// List reads and decodes the complete catalog. A missing ConfigMap or key
+// is an error rather than an empty catalog, so a deleted ConfigMap does not
+// delete every synced Armament.
+func (c *ConfigMapClient) List(ctx context.Context) ([]external.Armament, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.reader.Get(ctx, c.name, cm); err != nil {
+		return nil, fmt.Errorf("failed to get catalog ConfigMap %s: %w", c.name, err)
+	}
+	data, ok := cm.Data[c.key]
+	if !ok {
+		return nil, fmt.Errorf("catalog ConfigMap %s has no key %q", c.name, c.key)
+	}
+	return Parse([]byte(data), c.format)
+}
+
+// Watch implements external.Watcher by registering an event handler for the
+// ConfigMap on the shared informer.
+func (c *ConfigMapClient) Watch(ctx context.Context) (<-chan struct{}, error) {
+	informer, err := c.informers.GetInformer(ctx, &corev1.ConfigMap{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ConfigMap informer: %w", err)
+	}
+
+	changes := make(chan struct{}, 1)
+	onEvent := func(obj any) {
+		if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		if o, ok := obj.(client.Object); ok && client.ObjectKeyFromObject(o) == c.name {
+			notify(changes)
+		}
+	}
+	registration, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    onEvent,
+		UpdateFunc: func(_, obj any) { onEvent(obj) },
+		DeleteFunc: onEvent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch catalog ConfigMap: %w", err)
+	}
+	go func() {
+		<-ctx.Done()
+		_ = informer.RemoveEventHandler(registration)
+	}()
+	return changes, nil
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// Format is the encoding of a catalog document.
+type Format string
+
+const (
+	// FormatYAML is a document with an "armaments" list whose items use the
+	// Armament CRD's field names.
+	FormatYAML Format = "yaml"
+
+	// FormatJSON is the JSON form of FormatYAML.
+	FormatJSON Format = "json"
+
+	// FormatCSV is a table with a header row naming the CSVColumns in any
+	// order. The length column applies to ropes or blades depending on the
+	// kind.
+	FormatCSV Format = "csv"
+)
+
+// CSVColumns are the columns understood in a FormatCSV catalog.
+var CSVColumns = []string{"externalID", "displayName", "kind", "damage", "range", "caliber", "capacity", "length"}
+
+// FormatFromName derives the format of a catalog from the extension of its
+// file or ConfigMap key.
+func FormatFromName(name string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	case ".json":
+		return FormatJSON, nil
+	case ".csv":
+		return FormatCSV, nil
+	}
+	return "", fmt.Errorf("cannot tell catalog format from %q: use a .yaml, .yml, .json or .csv name", name)
+}
+
+// catalogDocument is the YAML and JSON representation of a catalog.
+type catalogDocument struct {
+	Armaments []catalogItem `json:"armaments"`
+}
+
+type catalogItem struct {
+	ExternalID  string            `json:"externalID"`
+	DisplayName string            `json:"displayName,omitempty"`
+	Kind        string            `json:"kind"`
+	Damage      int32             `json:"damage,omitempty"`
+	Range       int32             `json:"range,omitempty"`
+	Firearm     *firearmItem      `json:"firearm,omitempty"`
+	Rope        *lengthAttributes `json:"rope,omitempty"`
+	Blade       *lengthAttributes `json:"blade,omitempty"`
+}
+
+type firearmItem struct {
+	Caliber  string `json:"caliber,omitempty"`
+	Capacity int32  `json:"capacity,omitempty"`
+}
+
+type lengthAttributes struct {
+	Length int32 `json:"length"`
+}
+
+// Parse decodes a catalog document. Items without an externalID and
+// duplicate externalIDs are rejected, since either would make the sync
+// flap between two versions of the same Armament.
+func Parse(data []byte, format Format) ([]external.Armament, error) {
+	var (
+		items []external.Armament
+		err   error
+	)
+	switch format {
+	case FormatYAML, FormatJSON:
+		items, err = parseDocument(data)
+	case FormatCSV:
+		items, err = parseCSV(data)
+	default:
+		return nil, fmt.Errorf("unsupported catalog format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		if item.ExternalID == "" {
+			return nil, fmt.Errorf("item %d: externalID must be set", i)
+		}
+		if seen[item.ExternalID] {
+			return nil, fmt.Errorf("item %d: duplicate externalID %q", i, item.ExternalID)
+		}
+		seen[item.ExternalID] = true
+	}
+	return items, nil
+}
+
+// parseDocument decodes the YAML and JSON formats. Unknown fields are
+// rejected so that typos do not silently drop attributes.
+func parseDocument(data []byte) ([]external.Armament, error) {
+	var doc catalogDocument
+	if err := yaml.UnmarshalStrict(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode catalog: %w", err)
+	}
+
+	items := make([]external.Armament, 0, len(doc.Armaments))
+	for _, in := range doc.Armaments {
+		out := external.Armament{
+			ExternalID:  in.ExternalID,
+			DisplayName: in.DisplayName,
+			Kind:        in.Kind,
+			Damage:      in.Damage,
+			Range:       in.Range,
+		}
+		if in.Firearm != nil {
+			out.Firearm = &external.FirearmAttributes{Caliber: in.Firearm.Caliber, Capacity: in.Firearm.Capacity}
+		}
+		if in.Rope != nil {
+			out.Rope = &external.RopeAttributes{Length: in.Rope.Length}
+		}
+		if in.Blade != nil {
+			out.Blade = &external.BladeAttributes{Length: in.Blade.Length}
+		}
+		items = append(items, out)
+	}
+	return items, nil
+}
+
+// parseCSV decodes the CSV format. Lines starting with # are comments.
+func parseCSV(data []byte) ([]external.Armament, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comment = '#'
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !slices.Contains(CSVColumns, name) {
+			return nil, fmt.Errorf("unknown catalog column %q, expected some of %s", name, strings.Join(CSVColumns, ", "))
+		}
+		if _, dup := columns[name]; dup {
+			return nil, fmt.Errorf("duplicate catalog column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["externalID"]; !ok {
+		return nil, fmt.Errorf("catalog has no externalID column")
+	}
+
+	var items []external.Armament
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return items, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read catalog: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+
+		cell := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		number := func(name string) (int32, error) {
+			v := cell(name)
+			if v == "" {
+				return 0, nil
+			}
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return 0, fmt.Errorf("line %d: %s: %w", line, name, err)
+			}
+			return int32(n), nil
+		}
+
+		item := external.Armament{
+			ExternalID:  cell("externalID"),
+			DisplayName: cell("displayName"),
+			Kind:        cell("kind"),
+		}
+		if item.Damage, err = number("damage"); err != nil {
+			return nil, err
+		}
+		if item.Range, err = number("range"); err != nil {
+			return nil, err
+		}
+		capacity, err := number("capacity")
+		if err != nil {
+			return nil, err
+		}
+		length, err := number("length")
+		if err != nil {
+			return nil, err
+		}
+		if caliber := cell("caliber"); caliber != "" || capacity != 0 {
+			item.Firearm = &external.FirearmAttributes{Caliber: caliber, Capacity: capacity}
+		}
+		if length != 0 {
+			switch strings.ToLower(item.Kind) {
+			case "rope":
+				item.Rope = &external.RopeAttributes{Length: length}
+			case "blade":
+				item.Blade = &external.BladeAttributes{Length: length}
+			}
+		}
+		items = append(items, item)
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+func TestParse(t *testing.T) {
+	colt := external.Armament{
+		ExternalID: "colt-saa", DisplayName: "Colt Single Action Army", Kind: "revolver", Damage: 50, Range: 50,
+		Firearm: &external.FirearmAttributes{Caliber: ".45 Colt", Capacity: 6},
+	}
+	lasso := external.Armament{
+		ExternalID: "lasso", DisplayName: "Lasso", Kind: "rope", Damage: 5, Range: 10,
+		Rope: &external.RopeAttributes{Length: 12},
+	}
+	bowie := external.Armament{
+		ExternalID: "bowie-knife", Kind: "blade", Damage: 20,
+		Blade: &external.BladeAttributes{Length: 25},
+	}
+
+	tests := []struct {
+		name    string
+		format  Format
+		data    string
+		want    []external.Armament
+		wantErr string
+	}{
+		{
+			name:   "yaml",
+			format: FormatYAML,
+			data: `armaments:
+  - externalID: colt-saa
+    displayName: Colt Single Action Army
+    kind: revolver
+    damage: 50
+    range: 50
+    firearm:
+      caliber: .45 Colt
+      capacity: 6
+  - externalID: lasso
+    displayName: Lasso
+    kind: rope
+    damage: 5
+    range: 10
+    rope:
+      length: 12
+`,
+			want: []external.Armament{colt, lasso},
+		},
+		{
+			name:   "json",
+			format: FormatJSON,
+			data:   `{"armaments": [{"externalID": "bowie-knife", "kind": "blade", "damage": 20, "blade": {"length": 25}}]}`,
+			want:   []external.Armament{bowie},
+		},
+		{
+			name:   "empty yaml",
+			format: FormatYAML,
+			data:   "armaments: []\n",
+			want:   []external.Armament{},
+		},
+		{
+			name:    "yaml with an unknown field",
+			format:  FormatYAML,
+			data:    "armaments:\n  - externalID: lasso\n    kind: rope\n    lenght: 12\n",
+			wantErr: "lenght",
+		},
+		{
+			name:    "yaml with a duplicate externalID",
+			format:  FormatYAML,
+			data:    "armaments:\n  - externalID: lasso\n  - externalID: lasso\n",
+			wantErr: `item 1: duplicate externalID "lasso"`,
+		},
+		{
+			name:    "yaml without externalID",
+			format:  FormatYAML,
+			data:    "armaments:\n  - kind: rope\n",
+			wantErr: "item 0: externalID must be set",
+		},
+		{
+			name:   "csv",
+			format: FormatCSV,
+			data: `# The catalog of the Rusty Spur.
+externalID,displayName,kind,damage,range,caliber,capacity,length
+colt-saa,Colt Single Action Army,revolver,50,50,.45 Colt,6,
+lasso, Lasso,rope,5,10,,,12
+bowie-knife,,blade,20,,,,25
+`,
+			want: []external.Armament{colt, lasso, bowie},
+		},
+		{
+			name:   "csv with columns in any order",
+			format: FormatCSV,
+			data:   "length,kind,externalID,damage\n25,blade,bowie-knife,20\n",
+			want:   []external.Armament{bowie},
+		},
+		{
+			name:   "empty csv",
+			format: FormatCSV,
+			data:   "",
+		},
+		{
+			name:    "csv with an unknown column",
+			format:  FormatCSV,
+			data:    "externalID,weight\nlasso,2\n",
+			wantErr: `unknown catalog column "weight"`,
+		},
+		{
+			name:    "csv with a duplicate column",
+			format:  FormatCSV,
+			data:    "externalID,kind,kind\nlasso,rope,rope\n",
+			wantErr: `duplicate catalog column "kind"`,
+		},
+		{
+			name:    "csv without externalID column",
+			format:  FormatCSV,
+			data:    "kind,damage\nrope,5\n",
+			wantErr: "no externalID column",
+		},
+		{
+			name:    "csv with a bad number",
+			format:  FormatCSV,
+			data:    "externalID,damage\nlasso,five\n",
+			wantErr: "line 2: damage",
+		},
+		{
+			name:    "csv with a short row",
+			format:  FormatCSV,
+			data:    "externalID,damage\nlasso\n",
+			wantErr: "wrong number of fields",
+		},
+		{
+			name:    "csv with a duplicate externalID",
+			format:  FormatCSV,
+			data:    "externalID\nlasso\nlasso\n",
+			wantErr: `item 1: duplicate externalID "lasso"`,
+		},
+		{
+			name:    "unsupported format",
+			format:  Format("toml"),
+			data:    "",
+			wantErr: `unsupported catalog format "toml"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.data), tt.format)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Parse() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatFromName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Format
+		wantErr bool
+	}{
+		{name: "catalog.yaml", want: FormatYAML},
+		{name: "catalog.YML", want: FormatYAML},
+		{name: "/etc/armament-sync/catalog/catalog.json", want: FormatJSON},
+		{name: "catalog.csv", want: FormatCSV},
+		{name: "catalog.txt", wantErr: true},
+		{name: "catalog", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := FormatFromName(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("FormatFromName(%q) error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("FormatFromName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package http is an external.Client that fetches the armament catalog from
+// a REST endpoint returning JSON. The shape of the response is described by
+// a FieldMapping, so existing catalog APIs can be consumed without an
+// adapter service. Endpoints that paginate with a cursor are listed page by
+// page, and endpoints with a change feed are read incrementally through
+// FeedClient.
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// DefaultTimeout bounds a catalog request when Options.Timeout is not set.
+const DefaultTimeout = 10 * time.Second
+
+// maxResponseBytes caps the size of a catalog response.
+const maxResponseBytes = 32 << 20
+
+// Options configures a Client.
+type Options struct {
+	// URL is the catalog endpoint. It is fetched with GET on every List, once
+	// per page if the endpoint paginates.
+	URL string
+
+	// Timeout bounds a single request. Zero means DefaultTimeout.
+	Timeout time.Duration
+
+	// CAFile is a PEM bundle used instead of the system roots to verify the
+	// endpoint's certificate.
+	CAFile string
+
+	// BearerTokenFile holds a token sent as "Authorization: Bearer". It is
+	// read on every request so rotated Secrets mounted as files are picked
+	// up without a restart.
+	BearerTokenFile string
+
+	// BasicAuthUsernameFile and BasicAuthPasswordFile hold basic auth
+	// credentials. They are read on every request and are mutually exclusive
+	// with BearerTokenFile.
+	BasicAuthUsernameFile string
+	BasicAuthPasswordFile string
+
+	// ItemsPath is the dot-separated path to the array of items in the
+	// response, e.g. "data.armaments". Empty means the response is the array.
+	ItemsPath string
+
+	// Fields maps external.Armament fields to paths within an item. Unset
+	// entries fall back to DefaultFieldMapping.
+	Fields FieldMapping
+
+	// CursorParam is the query parameter that carries the cursor of the
+	// requested page, e.g. "cursor". Empty means the endpoint returns the
+	// whole catalog in one response.
+	CursorParam string
+
+	// LimitParam is the query parameter that carries the page size, e.g.
+	// "limit". Empty leaves the page size to the endpoint.
+	LimitParam string
+
+	// NextCursorPath is the dot-separated path to the cursor of the next page
+	// in the response, e.g. "metadata.continue". A missing, null or empty
+	// cursor marks the last page. It must be set together with CursorParam.
+	NextCursorPath string
+
+	// ChangesURL is the change feed endpoint used by FeedClient. It is not
+	// used by Client.
+	ChangesURL string
+
+	// SinceParam is the query parameter that carries the watermark on
+	// requests to ChangesURL. Empty means DefaultSinceParam.
+	SinceParam string
+}
+
+// Client fetches the armament catalog from a REST endpoint.
+type Client struct {
+	opts   Options
+	url    *url.URL
+	client *nethttp.Client
+}
+
+// New returns an HTTP external.Client. It fails when the options are
+// inconsistent or the CA bundle cannot be loaded.
+func New(opts Options) (*Client, error) {
+	if opts.URL == "" {
+		return nil, fmt.Errorf("catalog URL must be set")
+	}
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid catalog URL: %w", err)
+	}
+	if (opts.CursorParam == "") != (opts.NextCursorPath == "") {
+		return nil, fmt.Errorf("pagination needs both a cursor parameter and a next cursor path")
+	}
+	if opts.LimitParam != "" && opts.CursorParam == "" {
+		return nil, fmt.Errorf("a limit parameter needs pagination to be configured")
+	}
+	if opts.BearerTokenFile != "" && (opts.BasicAuthUsernameFile != "" || opts.BasicAuthPasswordFile != "") {
+		return nil, fmt.Errorf("bearer token and basic auth are mutually exclusive")
+	}
+	if (opts.BasicAuthUsernameFile == "") != (opts.BasicAuthPasswordFile == "") {
+		return nil, fmt.Errorf("basic auth needs both a username and a password file")
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	fields, err := opts.Fields.withDefaults()
+	if err != nil {
+		return nil, err
+	}
+	opts.Fields = fields
+
+	transport := nethttp.DefaultTransport.(*nethttp.Transport).Clone()
+	if opts.CAFile != "" {
+		pem, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", opts.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &Client{
+		opts:   opts,
+		url:    u,
+		client: &nethttp.Client{Transport: transport, Timeout: opts.Timeout},
+	}, nil
+}
+
+// List fetches and decodes the complete catalog, following the cursor
+// through every page if the endpoint paginates. Every page is checked for
+// duplicate external IDs as it is decoded; List also rejects an external ID
+// that appears on more than one page.
+func (c *Client) List(ctx context.Context) ([]external.Armament, error) {
+	var armaments []external.Armament
+	seen := map[string]bool{}
+	err := external.ForEachPage(ctx, c, 0, func(items []external.Armament) error {
+		for _, a := range items {
+			if seen[a.ExternalID] {
+				return fmt.Errorf("catalog page lists duplicate %s %q from an earlier page", FieldExternalID, a.ExternalID)
+			}
+			seen[a.ExternalID] = true
+		}
+		armaments = append(armaments, items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return armaments, nil
+}
+
+// ListPage implements external.Pager. Without pagination configured the
+// first page is the whole catalog.
+func (c *Client) ListPage(ctx context.Context, cursor string, limit int) (external.Page, error) {
+	u := *c.url
+	if c.opts.CursorParam != "" {
+		q := u.Query()
+		if cursor != "" {
+			q.Set(c.opts.CursorParam, cursor)
+		}
+		if c.opts.LimitParam != "" && limit > 0 {
+			q.Set(c.opts.LimitParam, strconv.Itoa(limit))
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	body, err := c.fetch(ctx, u.String())
+	if err != nil {
+		return external.Page{}, err
+	}
+	items, err := decodeItems(body, c.opts.ItemsPath, c.opts.Fields)
+	if err != nil {
+		return external.Page{}, err
+	}
+	page := external.Page{Items: items}
+	if c.opts.NextCursorPath != "" {
+		if page.Continue, err = nextCursor(body, c.opts.NextCursorPath); err != nil {
+			return external.Page{}, err
+		}
+	}
+	return page, nil
+}
+
+// fetch GETs a catalog URL and decodes the JSON response.
+func (c *Client) fetch(ctx context.Context, rawURL string) (any, error) {
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build catalog request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if err := c.authorize(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch catalog: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &statusError{code: resp.StatusCode, status: resp.Status}
+	}
+
+	// Read one byte past the limit so an oversized response is reported as
+	// such rather than as truncated JSON.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog: %w", err)
+	}
+	if len(data) > maxResponseBytes {
+		return nil, fmt.Errorf("catalog response exceeds %d bytes", maxResponseBytes)
+	}
+
+	var body any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode catalog: %w", err)
+	}
+	return body, nil
+}
+
+// authorize adds the configured credentials to req.
+func (c *Client) authorize(req *nethttp.Request) error {
+	switch {
+	case c.opts.BearerTokenFile != "":
+		token, err := readCredential(c.opts.BearerTokenFile)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	case c.opts.BasicAuthUsernameFile != "":
+		username, err := readCredential(c.opts.BasicAuthUsernameFile)
+		if err != nil {
+			return err
+		}
+		password, err := readCredential(c.opts.BasicAuthPasswordFile)
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(username, password)
+	}
+	return nil
+}
+
+// readCredential reads a credential from a mounted Secret key. Trailing
+// newlines, which editors and `kubectl create secret --from-file` tend to
+// leave behind, are dropped.
+func readCredential(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read credential file: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// statusError reports a non-2xx response from the catalog endpoint.
+type statusError struct {
+	code   int
+	status string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("catalog endpoint returned %s", e.status)
+}
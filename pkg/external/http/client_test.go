@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+func TestClientList(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []external.Armament
+		wantErr string
+	}{
+		{
+			name: "default mapping uses the Armament field names",
+			body: `[{"externalID": "colt-saa", "displayName": "Colt SAA", "kind": "revolver", "damage": 50, "range": 50,
+				"firearm": {"caliber": ".45 Colt", "capacity": 6}}]`,
+			want: []external.Armament{{
+				ExternalID: "colt-saa", DisplayName: "Colt SAA", Kind: "revolver", Damage: 50, Range: 50,
+				Firearm: &external.FirearmAttributes{Caliber: ".45 Colt", Capacity: 6},
+			}},
+		},
+		{
+			name:    "duplicate external IDs are rejected",
+			body:    `[{"externalID": "lasso"}, {"externalID": "lasso"}]`,
+			wantErr: `duplicate externalID "lasso"`,
+		},
+		{
+			name:    "missing external ID is rejected",
+			body:    `[{"id": "lasso"}]`,
+			wantErr: "missing externalID",
+		},
+		{
+			name:    "oversized response is rejected",
+			body:    `[` + strings.Repeat(" ", maxResponseBytes) + `]`,
+			wantErr: fmt.Sprintf("exceeds %d bytes", maxResponseBytes),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			c, err := New(Options{URL: srv.URL})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			got, err := c.List(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("List() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientListPages(t *testing.T) {
+	tests := []struct {
+		name    string
+		pages   map[string]string
+		want    []external.Armament
+		wantErr string
+	}{
+		{
+			name: "follows the cursor through every page",
+			pages: map[string]string{
+				"":  `{"items": [{"externalID": "lasso"}], "next": "2"}`,
+				"2": `{"items": [{"externalID": "bullwhip"}], "next": ""}`,
+			},
+			want: []external.Armament{{ExternalID: "lasso"}, {ExternalID: "bullwhip"}},
+		},
+		{
+			name: "duplicate external IDs across pages are rejected",
+			pages: map[string]string{
+				"":  `{"items": [{"externalID": "lasso"}], "next": "2"}`,
+				"2": `{"items": [{"externalID": "lasso"}], "next": ""}`,
+			},
+			wantErr: `duplicate externalID "lasso"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				body, ok := tt.pages[r.URL.Query().Get("cursor")]
+				if !ok {
+					nethttp.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, body)
+			}))
+			defer srv.Close()
+
+			c, err := New(Options{URL: srv.URL, ItemsPath: "items", CursorParam: "cursor", NextCursorPath: "next"})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			got, err := c.List(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("List() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"net/url"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// DefaultSinceParam is the query parameter that carries the watermark when
+// Options.SinceParam is not set.
+const DefaultSinceParam = "since"
+
+// FeedClient is a Client whose endpoint also serves a change feed. It
+// implements external.DeltaClient, so the sync controller reads only the
+// changes between full syncs.
+//
+// The feed is fetched with GET on Options.ChangesURL, passing the watermark
+// in Options.SinceParam. The response is a JSON object of the form
+//
+//	{"watermark": "42", "changes": [{"deleted": false, "item": {...}}]}
+//
+// where every item is decoded with the client's FieldMapping; deleted
+// entries only need the external ID. Without a watermark the endpoint
+// returns its current position and any changes in that response are
+// ignored. The endpoint answers 410 Gone when it no longer has the changes
+// since a watermark.
+type FeedClient struct {
+	*Client
+
+	changesURL *url.URL
+	sinceParam string
+}
+
+// NewFeed returns an HTTP external.DeltaClient. Besides the options New
+// checks, it needs Options.ChangesURL.
+func NewFeed(opts Options) (*FeedClient, error) {
+	if opts.ChangesURL == "" {
+		return nil, fmt.Errorf("change feed URL must be set")
+	}
+	u, err := url.Parse(opts.ChangesURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid change feed URL: %w", err)
+	}
+	c, err := New(opts)
+	if err != nil {
+		return nil, err
+	}
+	sinceParam := opts.SinceParam
+	if sinceParam == "" {
+		sinceParam = DefaultSinceParam
+	}
+	return &FeedClient{Client: c, changesURL: u, sinceParam: sinceParam}, nil
+}
+
+// Changes implements external.DeltaClient.
+func (c *FeedClient) Changes(ctx context.Context, watermark string) (external.Delta, error) {
+	u := *c.changesURL
+	if watermark != "" {
+		q := u.Query()
+		q.Set(c.sinceParam, watermark)
+		u.RawQuery = q.Encode()
+	}
+
+	body, err := c.fetch(ctx, u.String())
+	if err != nil {
+		var status *statusError
+		if errors.As(err, &status) && status.code == nethttp.StatusGone {
+			return external.Delta{}, fmt.Errorf("%w: %s", external.ErrWatermarkExpired, watermark)
+		}
+		return external.Delta{}, err
+	}
+
+	next, err := nextCursor(body, "watermark")
+	if err != nil {
+		return external.Delta{}, err
+	}
+	if next == "" {
+		return external.Delta{}, fmt.Errorf("change feed response has no watermark")
+	}
+	if watermark == "" {
+		return external.Delta{Watermark: next}, nil
+	}
+
+	changes, err := decodeChanges(body, c.opts.Fields)
+	if err != nil {
+		return external.Delta{}, err
+	}
+	return external.Delta{Changes: changes, Watermark: next}, nil
+}
+
+// decodeChanges extracts the changes from a decoded change feed response.
+func decodeChanges(body any, fields FieldMapping) ([]external.Change, error) {
+	raw, ok := lookup(body, "changes")
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	entries, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("changes in the change feed response are not an array")
+	}
+
+	changes := make([]external.Change, 0, len(entries))
+	for i, entry := range entries {
+		var change external.Change
+		if deleted, ok := lookup(entry, "deleted"); ok && deleted != nil {
+			if change.Deleted, ok = deleted.(bool); !ok {
+				return nil, fmt.Errorf("change %d: deleted is not a boolean", i)
+			}
+		}
+		item, ok := lookup(entry, "item")
+		if !ok {
+			return nil, fmt.Errorf("change %d has no item", i)
+		}
+		a, err := decodeItem(item, fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode change %d: %w", i, err)
+		}
+		change.Armament = a
+		if change.Deleted {
+			change.Armament = external.Armament{ExternalID: a.ExternalID}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
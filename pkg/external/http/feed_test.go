@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// feedServer serves a change feed whose oldest retained watermark is 40 and
+// whose current watermark is 42.
+func feedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch since := r.URL.Query().Get("since"); since {
+		case "":
+			fmt.Fprint(w, `{"watermark": "42", "changes": [{"item": {"externalID": "ignored"}}]}`)
+		case "40":
+			fmt.Fprint(w, `{"watermark": "42", "changes": [
+				{"item": {"externalID": "lasso", "kind": "rope", "damage": 6, "rope": {"length": 15}}},
+				{"deleted": true, "item": {"externalID": "bowie-knife"}}
+			]}`)
+		case "42":
+			fmt.Fprint(w, `{"watermark": "42", "changes": []}`)
+		default:
+			w.WriteHeader(nethttp.StatusGone)
+		}
+	}))
+}
+
+func TestFeedClientChanges(t *testing.T) {
+	srv := feedServer(t)
+	defer srv.Close()
+
+	c, err := NewFeed(Options{URL: srv.URL, ChangesURL: srv.URL})
+	if err != nil {
+		t.Fatalf("NewFeed: %v", err)
+	}
+	var _ external.DeltaClient = c
+
+	tests := []struct {
+		name      string
+		watermark string
+		want      external.Delta
+		wantErr   error
+	}{
+		{
+			name:      "empty watermark returns the current position only",
+			watermark: "",
+			want:      external.Delta{Watermark: "42"},
+		},
+		{
+			name:      "changes since a watermark advance it",
+			watermark: "40",
+			want: external.Delta{
+				Watermark: "42",
+				Changes: []external.Change{
+					{Armament: external.Armament{
+						ExternalID: "lasso", Kind: "rope", Damage: 6,
+						Rope: &external.RopeAttributes{Length: 15},
+					}},
+					{Armament: external.Armament{ExternalID: "bowie-knife"}, Deleted: true},
+				},
+			},
+		},
+		{
+			name:      "no changes keep the watermark",
+			watermark: "42",
+			want:      external.Delta{Watermark: "42", Changes: []external.Change{}},
+		},
+		{
+			name:      "gone watermark is expired",
+			watermark: "7",
+			wantErr:   external.ErrWatermarkExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Changes(context.Background(), tt.watermark)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Changes(%q) error = %v, want %v", tt.watermark, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Changes(%q): %v", tt.watermark, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Changes(%q) = %+v, want %+v", tt.watermark, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFeedRequiresChangesURL(t *testing.T) {
+	if _, err := NewFeed(Options{URL: "https://catalog.example.com"}); err == nil {
+		t.Fatal("NewFeed without a change feed URL succeeded")
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2025 The Platform Mesh Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/platform-mesh/provider-quickstart/pkg/external"
+)
+
+// Fields of external.Armament that can be mapped.
+const (
+	FieldExternalID  = "externalID"
+	FieldDisplayName = "displayName"
+	FieldKind        = "kind"
+	FieldDamage      = "damage"
+	FieldRange       = "range"
+	FieldCaliber     = "caliber"
+	FieldCapacity    = "capacity"
+	FieldRopeLength  = "ropeLength"
+	FieldBladeLength = "bladeLength"
+)
+
+// FieldMapping maps external.Armament fields, named by the Field constants,
+// to dot-separated paths within a catalog item, e.g. "stats.damage".
+type FieldMapping map[string]string
+
+// DefaultFieldMapping matches a catalog that uses the Armament CRD's own
+// field names.
+var DefaultFieldMapping = FieldMapping{
+	FieldExternalID:  "externalID",
+	FieldDisplayName: "displayName",
+	FieldKind:        "kind",
+	FieldDamage:      "damage",
+	FieldRange:       "range",
+	FieldCaliber:     "firearm.caliber",
+	FieldCapacity:    "firearm.capacity",
+	FieldRopeLength:  "rope.length",
+	FieldBladeLength: "blade.length",
+}
+
+// withDefaults returns the mapping with unset fields taken from
+// DefaultFieldMapping. Unknown fields are rejected so typos in flags do not
+// silently fall back to the default.
+func (m FieldMapping) withDefaults() (FieldMapping, error) {
+	out := make(FieldMapping, len(DefaultFieldMapping))
+	for field, path := range DefaultFieldMapping {
+		out[field] = path
+	}
+	var unknown []string
+	for field, path := range m {
+		if _, ok := DefaultFieldMapping[field]; !ok {
+			unknown = append(unknown, field)
+			continue
+		}
+		if path != "" {
+			out[field] = path
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown fields in mapping: %s", strings.Join(unknown, ", "))
+	}
+	return out, nil
+}
+
+// decodeItems extracts the catalog items from a decoded response. Duplicate
+// external IDs are rejected, since they would make the sync flap between two
+// versions of the same Armament.
+func decodeItems(body any, itemsPath string, fields FieldMapping) ([]external.Armament, error) {
+	raw, ok := lookup(body, itemsPath)
+	if !ok {
+		return nil, fmt.Errorf("catalog response has no %q", itemsPath)
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("catalog items at %q are not an array", itemsPath)
+	}
+
+	armaments := make([]external.Armament, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		a, err := decodeItem(item, fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode catalog item %d: %w", i, err)
+		}
+		if seen[a.ExternalID] {
+			return nil, fmt.Errorf("catalog item %d: duplicate %s %q", i, FieldExternalID, a.ExternalID)
+		}
+		seen[a.ExternalID] = true
+		armaments = append(armaments, a)
+	}
+	return armaments, nil
+}
+
+// nextCursor extracts the cursor of the next page from a decoded response.
+func nextCursor(body any, path string) (string, error) {
+	v, ok := lookup(body, path)
+	if !ok || v == nil {
+		return "", nil
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("next cursor at %q is not a string", path)
+	}
+}
+
+// decodeItem maps a single catalog item onto external.Armament. Only the
+// external ID is required; attribute blocks are set when any of their fields
+// is present.
+func decodeItem(item any, fields FieldMapping) (external.Armament, error) {
+	d := decoder{item: item, fields: fields}
+	a := external.Armament{
+		ExternalID:  d.string(FieldExternalID),
+		DisplayName: d.string(FieldDisplayName),
+		Kind:        d.string(FieldKind),
+		Damage:      d.int32(FieldDamage),
+		Range:       d.int32(FieldRange),
+	}
+	if d.has(FieldCaliber) || d.has(FieldCapacity) {
+		a.Firearm = &external.FirearmAttributes{Caliber: d.string(FieldCaliber), Capacity: d.int32(FieldCapacity)}
+	}
+	if d.has(FieldRopeLength) {
+		a.Rope = &external.RopeAttributes{Length: d.int32(FieldRopeLength)}
+	}
+	if d.has(FieldBladeLength) {
+		a.Blade = &external.BladeAttributes{Length: d.int32(FieldBladeLength)}
+	}
+	if d.err != nil {
+		return external.Armament{}, d.err
+	}
+	if a.ExternalID == "" {
+		return external.Armament{}, fmt.Errorf("missing %s at %q", FieldExternalID, fields[FieldExternalID])
+	}
+	return a, nil
+}
+
+// decoder reads mapped fields from an item and keeps the first error.
+type decoder struct {
+	item   any
+	fields FieldMapping
+	err    error
+}
+
+func (d *decoder) has(field string) bool {
+	v, ok := lookup(d.item, d.fields[field])
+	return ok && v != nil
+}
+
+func (d *decoder) string(field string) string {
+	v, ok := lookup(d.item, d.fields[field])
+	if !ok || v == nil {
+		return ""
+	}
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		d.fail(field, "a string")
+		return ""
+	}
+}
+
+func (d *decoder) int32(field string) int32 {
+	v, ok := lookup(d.item, d.fields[field])
+	if !ok || v == nil {
+		return 0
+	}
+	n, ok := v.(json.Number)
+	if !ok {
+		d.fail(field, "a number")
+		return 0
+	}
+	i, err := n.Int64()
+	if err != nil || i < math.MinInt32 || i > math.MaxInt32 {
+		d.fail(field, "a 32-bit integer")
+		return 0
+	}
+	return int32(i)
+}
+
+func (d *decoder) fail(field, want string) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%s at %q is not %s", field, d.fields[field], want)
+	}
+}
+
+// lookup walks a dot-separated path through nested JSON objects. An empty
+// path returns v itself.
+func lookup(v any, path string) (any, bool) {
+	if path == "" {
+		return v, true
+	}
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
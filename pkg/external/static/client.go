@@ -33,9 +33,21 @@ func New() *Client { return &Client{} }
 // List returns the hardcoded armament catalog.
 func (c *Client) List(_ context.Context) ([]external.Armament, error) {
 	return []external.Armament{
-		{ExternalID: "colt-saa", DisplayName: "Colt Single Action Army", Kind: "revolver", Damage: 50, Range: 50},
-		{ExternalID: "winchester-1873", DisplayName: "Winchester Model 1873", Kind: "rifle", Damage: 80, Range: 400},
-		{ExternalID: "lasso", DisplayName: "Lasso", Kind: "rope", Damage: 5, Range: 10},
-		{ExternalID: "bowie-knife", DisplayName: "Bowie Knife", Kind: "blade", Damage: 30, Range: 2},
+		{
+			ExternalID: "colt-saa", DisplayName: "Colt Single Action Army", Kind: "revolver", Damage: 50, Range: 50,
+			Firearm: &external.FirearmAttributes{Caliber: ".45 Colt", Capacity: 6},
+		},
+		{
+			ExternalID: "winchester-1873", DisplayName: "Winchester Model 1873", Kind: "rifle", Damage: 80, Range: 400,
+			Firearm: &external.FirearmAttributes{Caliber: ".44-40", Capacity: 15},
+		},
+		{
+			ExternalID: "lasso", DisplayName: "Lasso", Kind: "rope", Damage: 5, Range: 10,
+			Rope: &external.RopeAttributes{Length: 12},
+		},
+		{
+			ExternalID: "bowie-knife", DisplayName: "Bowie Knife", Kind: "blade", Damage: 30, Range: 2,
+			Blade: &external.BladeAttributes{Length: 25},
+		},
 	}, nil
 }